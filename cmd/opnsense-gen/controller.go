@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	errNoNamespace = errors.New("no API namespace found in controller")
+	errNoClass     = errors.New("no controller class found")
+	errNoCRUD      = errors.New("controller has no add, set or del action")
+	errNoKey       = errors.New("no item key found in controller")
+
+	namespaceRegexp  = regexp.MustCompile(`namespace\s+OPNsense\\(\w+)\\Api\s*;`)
+	classRegexp      = regexp.MustCompile(`class\s+(\w+)Controller\s+extends`)
+	actionRegexp     = regexp.MustCompile(`function\s+(\w+)Action\s*\(`)
+	baseRegexp       = regexp.MustCompile(`\$this->(?:get|set|add)Base\(\s*['"](\w+)['"]\s*,\s*['"]([\w.]+)['"]`)
+	modelClassRegexp = regexp.MustCompile(`\$internalModelClass\s*=\s*['"]\\?([^'"]+)['"]`)
+	crudRegexp       = regexp.MustCompile(`^(get|search|add|set|del|toggle)(\w+)$`)
+)
+
+// controller is what the generator needs to know about an API controller
+// derived from ApiMutableModelControllerBase.
+type controller struct {
+	// Module is the route of the controller, e.g. firewall/source_nat.
+	Module string
+	// Key wraps the item in requests and responses, e.g. rule.
+	Key string
+	// Suffix of the CRUD actions, e.g. Rule for getRule.
+	Suffix string
+	// ModelPath is the dotted path of the ArrayField, e.g. rules.rule.
+	ModelPath string
+	// ModelClass is the PHP class of the model, e.g. OPNsense\Firewall\Alias.
+	ModelClass  string
+	Actions     map[string]bool
+	Reconfigure string
+}
+
+func parseController(source string) (*controller, error) {
+	namespace := namespaceRegexp.FindStringSubmatch(source)
+	if namespace == nil {
+		return nil, errNoNamespace
+	}
+
+	class := classRegexp.FindStringSubmatch(source)
+	if class == nil {
+		return nil, errNoClass
+	}
+
+	ctrl := &controller{
+		Module:  path.Join(strings.ToLower(namespace[1]), snakeCase(class[1])),
+		Actions: map[string]bool{},
+	}
+
+	for _, match := range actionRegexp.FindAllStringSubmatch(source, -1) {
+		action := match[1]
+
+		if crud := crudRegexp.FindStringSubmatch(action); crud != nil {
+			ctrl.Actions[crud[1]] = true
+
+			if crud[1] == "add" || ctrl.Suffix == "" {
+				ctrl.Suffix = crud[2]
+			}
+
+			continue
+		}
+
+		switch action {
+		case "reconfigure", "apply":
+			ctrl.Reconfigure = path.Join(ctrl.Module, action)
+		}
+	}
+
+	if !ctrl.Actions["add"] && !ctrl.Actions["set"] && !ctrl.Actions["del"] {
+		return nil, fmt.Errorf("%w: %s", errNoCRUD, ctrl.Module)
+	}
+
+	base := baseRegexp.FindStringSubmatch(source)
+	if base == nil {
+		return nil, fmt.Errorf("%w: %s has no getBase, setBase or addBase call", errNoKey, ctrl.Module)
+	}
+
+	ctrl.Key = base[1]
+	ctrl.ModelPath = base[2]
+
+	if model := modelClassRegexp.FindStringSubmatch(source); model != nil {
+		ctrl.ModelClass = strings.ReplaceAll(model[1], `\\`, `\`)
+	}
+
+	return ctrl, nil
+}
+
+// modelFile guesses the location of the model definition from the location
+// of the controller in an OPNsense source checkout:
+// .../mvc/app/controllers/OPNsense/Firewall/Api/CategoryController.php
+// .../mvc/app/models/OPNsense/Firewall/Category.xml.
+func (c *controller) modelFile(controllerFile string) (string, bool) {
+	if c.ModelClass == "" {
+		return "", false
+	}
+
+	dir := filepath.ToSlash(controllerFile)
+
+	i := strings.LastIndex(dir, "/controllers/")
+	if i < 0 {
+		return "", false
+	}
+
+	class := strings.ReplaceAll(c.ModelClass, `\`, "/")
+
+	return filepath.FromSlash(dir[:i] + "/models/" + class + ".xml"), true
+}
+
+// snakeCase converts a controller class to its route, e.g. SourceNat to
+// source_nat.
+func snakeCase(name string) string {
+	var sb strings.Builder
+
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+// binding is everything needed to render the Go code for one model.
+type binding struct {
+	Name       string
+	Source     string
+	Controller *controller
+	Fields     []field
+}
+
+func (b binding) Structs() string {
+	var sb strings.Builder
+
+	writeStruct(&sb, b.Name+"Get", b.Fields, true, true)
+	writeStruct(&sb, b.Name+"Set", b.Fields, false, true)
+
+	return sb.String()
+}
+
+// writeStruct writes a struct for the given fields, followed by the structs
+// for its containers.
+func writeStruct(sb *strings.Builder, name string, fields []field, get, top bool) {
+	nested := []field{}
+
+	fmt.Fprintf(sb, "type %s struct {\n", name)
+
+	if top {
+		sb.WriteString("UUID *uuid.UUID `json:\"uuid,omitempty\"`\n")
+	}
+
+	for _, f := range fields {
+		t := f.SetType
+		if get {
+			t = f.GetType
+		}
+
+		if f.Fields != nil {
+			t = containerName(name, f)
+			nested = append(nested, f)
+		}
+
+		// Leave out unset pointers, which would be sent as null.
+		tag := f.JSONName
+		if strings.HasPrefix(t, "*") {
+			tag += ",omitempty"
+		}
+
+		fmt.Fprintf(sb, "%s %s `json:%q`\n", f.Name, t, tag)
+	}
+
+	sb.WriteString("}\n\n")
+
+	for _, f := range nested {
+		writeStruct(sb, containerName(name, f), f.Fields, get, false)
+	}
+}
+
+// containerName prefixes the container with the struct it belongs to while
+// keeping the Get or Set suffix at the end, e.g. RuleSourceGet.
+func containerName(parent string, f field) string {
+	for _, suffix := range []string{"Get", "Set"} {
+		if base, ok := strings.CutSuffix(parent, suffix); ok {
+			return base + f.Name + suffix
+		}
+	}
+
+	return parent + f.Name
+}
+
+func (b binding) Lower() string {
+	return strings.ToLower(b.Name[:1]) + b.Name[1:]
+}
+
+const bindingTemplate = `// Code generated by opnsense-gen from {{ .Source }}. DO NOT EDIT.
+
+package opnsense
+
+import (
+	"context"
+	"fmt"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+{{ .Structs }}
+
+func (g *{{ .Name }}Get) setUUID(id uuid.UUID) {
+	g.UUID = &id
+}
+
+func (c *Client) {{ .Lower }}Resource() *Resource[{{ .Name }}Get, {{ .Name }}Set] {
+	return NewResource[{{ .Name }}Get, {{ .Name }}Set](c, ResourceConfig{
+		Module:      "{{ .Controller.Module }}",
+		Key:         "{{ .Controller.Key }}",
+		Suffix:      "{{ .Controller.Suffix }}",
+		Reconfigure: "{{ .Controller.Reconfigure }}",
+	})
+}
+{{ if .Controller.Actions.get }}
+func (c *Client) {{ .Name }}Get(uuid uuid.UUID) (*{{ .Name }}Get, error) {
+	return c.{{ .Name }}GetCtx(context.Background(), uuid)
+}
+
+func (c *Client) {{ .Name }}GetCtx(ctx context.Context, uuid uuid.UUID) (*{{ .Name }}Get, error) {
+	return c.{{ .Lower }}Resource().Get(ctx, uuid)
+}
+{{ end }}{{ if .Controller.Actions.search }}
+func (c *Client) {{ .Name }}GetUUIDs() ([]*uuid.UUID, error) {
+	return c.{{ .Name }}GetUUIDsCtx(context.Background())
+}
+
+func (c *Client) {{ .Name }}GetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	return c.{{ .Lower }}Resource().UUIDs(ctx)
+}
+{{ if .Controller.Actions.get }}
+func (c *Client) {{ .Name }}List() ([]*{{ .Name }}Get, error) {
+	return c.{{ .Name }}ListCtx(context.Background())
+}
+
+func (c *Client) {{ .Name }}ListCtx(ctx context.Context) ([]*{{ .Name }}Get, error) {
+	return c.{{ .Lower }}Resource().List(ctx)
+}
+{{ end }}{{ end }}{{ if .Controller.Actions.add }}
+func (c *Client) {{ .Name }}Add(conf {{ .Name }}Set) (*uuid.UUID, error) {
+	return c.{{ .Name }}AddCtx(context.Background(), conf)
+}
+
+func (c *Client) {{ .Name }}AddCtx(ctx context.Context, conf {{ .Name }}Set) (*uuid.UUID, error) {
+	response, err := c.{{ .Lower }}Resource().Add(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}Add failed: %w", err)
+	}
+
+	return response.UUID, nil
+}
+{{ end }}{{ if .Controller.Actions.set }}
+func (c *Client) {{ .Name }}Set(uuid uuid.UUID, conf {{ .Name }}Set) (*GenericResponse, error) {
+	return c.{{ .Name }}SetCtx(context.Background(), uuid, conf)
+}
+
+func (c *Client) {{ .Name }}SetCtx(ctx context.Context, uuid uuid.UUID, conf {{ .Name }}Set) (*GenericResponse, error) {
+	response, err := c.{{ .Lower }}Resource().Set(ctx, uuid, conf)
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}Set failed: %w", err)
+	}
+
+	return response, nil
+}
+{{ end }}{{ if .Controller.Actions.del }}
+func (c *Client) {{ .Name }}Delete(uuid uuid.UUID) (*GenericResponse, error) {
+	return c.{{ .Name }}DeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) {{ .Name }}DeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error) {
+	response, err := c.{{ .Lower }}Resource().Delete(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}Delete failed: %w", err)
+	}
+
+	return response, nil
+}
+{{ end }}{{ if .Controller.Actions.toggle }}
+func (c *Client) {{ .Name }}Toggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.{{ .Name }}ToggleCtx(context.Background(), uuid, enabled)
+}
+
+func (c *Client) {{ .Name }}ToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.{{ .Lower }}Resource().Toggle(ctx, uuid, enabled)
+}
+{{ end }}{{ if .Controller.Reconfigure }}
+func (c *Client) {{ .Name }}Reconfigure() error {
+	return c.{{ .Name }}ReconfigureCtx(context.Background())
+}
+
+func (c *Client) {{ .Name }}ReconfigureCtx(ctx context.Context) error {
+	return c.{{ .Lower }}Resource().Reconfigure(ctx)
+}
+{{ end }}`
+
+func generate(b binding) ([]byte, error) {
+	tmpl, err := template.New("binding").Parse(bindingTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = tmpl.Execute(&buf, b)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.String())
+	}
+
+	return source, nil
+}
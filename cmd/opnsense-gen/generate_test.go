@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseController(t *testing.T) {
+	source, err := os.ReadFile(filepath.Join("testdata", "CategoryController.php"))
+	require.NoError(t, err)
+
+	ctrl, err := parseController(string(source))
+	require.NoError(t, err)
+
+	assert.Equal(t, "firewall/category", ctrl.Module)
+	assert.Equal(t, "category", ctrl.Key)
+	assert.Equal(t, "Item", ctrl.Suffix)
+	assert.Equal(t, "categories.category", ctrl.ModelPath)
+	assert.Equal(t, map[string]bool{"search": true, "get": true, "add": true, "set": true, "del": true}, ctrl.Actions)
+
+	model, ok := ctrl.modelFile("/src/opnsense/mvc/app/controllers/OPNsense/Firewall/Api/CategoryController.php")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.FromSlash("/src/opnsense/mvc/app/models/OPNsense/Firewall/Category.xml"), model)
+
+	_, err = parseController(strings.ReplaceAll(string(source), `"category", "categories.category"`, `"", "categories.category"`))
+	assert.ErrorIs(t, err, errNoKey)
+}
+
+func TestFieldsOf(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "Category.xml"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	m, err := parseModel(f)
+	require.NoError(t, err)
+
+	array, err := m.arrayField("categories.category")
+	require.NoError(t, err)
+
+	assert.Equal(t, []field{
+		{Name: "Name", JSONName: "name", GetType: "string", SetType: "string"},
+		{Name: "Auto", JSONName: "auto", GetType: "Bool", SetType: "Bool"},
+		{Name: "Color", JSONName: "color", GetType: "string", SetType: "string"},
+	}, fieldsOf(*array))
+
+	_, err = m.arrayField("categories.missing")
+	assert.ErrorIs(t, err, errNoArrayField)
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"name":             "Name",
+		"source_net":       "SourceNet",
+		"ipprotocol":       "Ipprotocol",
+		"tunneladdress":    "Tunneladdress",
+		"dns":              "DNS",
+		"local_ip":         "LocalIP",
+		"pubkey":           "Pubkey",
+		"update-source-ip": "UpdateSourceIP",
+	}
+
+	for input, want := range tests {
+		assert.Equal(t, want, goName(input), input)
+	}
+}
+
+func TestWriteStructOmitsNilPointers(t *testing.T) {
+	var sb strings.Builder
+
+	writeStruct(&sb, "RuleSet", []field{
+		{Name: "Port", JSONName: "port", GetType: "*PortRange", SetType: "*PortRange"},
+		{Name: "Log", JSONName: "log", GetType: "Bool", SetType: "Bool"},
+	}, false, false)
+
+	assert.Contains(t, sb.String(), "Port *PortRange `json:\"port,omitempty\"`")
+	assert.Contains(t, sb.String(), "Log Bool `json:\"log\"`")
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "firewall_category.go")
+
+	err := run(
+		filepath.Join("testdata", "CategoryController.php"),
+		filepath.Join("testdata", "Category.xml"),
+		"FirewallCategory",
+		"firewall/filter/apply",
+		out,
+	)
+	require.NoError(t, err)
+
+	code, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	source := string(code)
+
+	for _, want := range []string{
+		"// Code generated by opnsense-gen from Category.xml and CategoryController.php. DO NOT EDIT.",
+		"type FirewallCategoryGet struct {",
+		"type FirewallCategorySet struct {",
+		"Auto  Bool       `json:\"auto\"`",
+		`Module:      "firewall/category",`,
+		`Key:         "category",`,
+		`Suffix:      "Item",`,
+		`Reconfigure: "firewall/filter/apply",`,
+		"func (c *Client) FirewallCategoryGetCtx(ctx context.Context, uuid uuid.UUID) (*FirewallCategoryGet, error) {",
+		"func (c *Client) FirewallCategoryListCtx(ctx context.Context) ([]*FirewallCategoryGet, error) {",
+		"func (c *Client) FirewallCategoryAddCtx(ctx context.Context, conf FirewallCategorySet) (*uuid.UUID, error) {",
+		"func (c *Client) FirewallCategoryDelete(uuid uuid.UUID) (*GenericResponse, error) {",
+		"func (c *Client) FirewallCategoryReconfigure() error {",
+	} {
+		assert.Contains(t, source, want)
+	}
+
+	assert.NotContains(t, source, "FirewallCategoryToggle")
+}
+
+func TestRunRequiresFlags(t *testing.T) {
+	err := run("", "", "", "", "")
+	assert.ErrorIs(t, err, errMissingFlag)
+
+	err = run(filepath.Join("testdata", "CategoryController.php"), "", "FirewallCategory", "", "")
+	assert.ErrorIs(t, err, errMissingFlag)
+	assert.True(t, strings.Contains(err.Error(), "-model"))
+}
+
+// TestGenerateBuilds type-checks the generated file as part of the opnsense
+// package, using an overlay so the source tree is left untouched.
+func TestGenerateBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go build in short mode")
+	}
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not available")
+	}
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "generated.go")
+
+	// The name must not clash with the hand-written category bindings.
+	err = run(
+		filepath.Join("testdata", "CategoryController.php"),
+		filepath.Join("testdata", "Category.xml"),
+		"GeneratedCategory",
+		"firewall/filter/apply",
+		out,
+	)
+	require.NoError(t, err)
+
+	pkgDir, err := filepath.Abs(filepath.Join("..", "..", "opnsense"))
+	require.NoError(t, err)
+
+	overlay, err := json.Marshal(map[string]map[string]string{
+		"Replace": {filepath.Join(pkgDir, "zz_generated.go"): out},
+	})
+	require.NoError(t, err)
+
+	overlayFile := filepath.Join(dir, "overlay.json")
+	require.NoError(t, os.WriteFile(overlayFile, overlay, 0o600))
+
+	cmd := exec.Command(goBin, "vet", "-overlay", overlayFile, ".")
+	cmd.Dir = pkgDir
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
@@ -0,0 +1,109 @@
+// Command opnsense-gen generates API bindings for the opnsense package from
+// the model definitions and API controllers in an OPNsense core or plugins
+// source checkout.
+//
+// Given an API controller derived from ApiMutableModelControllerBase it
+// emits a Get and a Set struct for the item the controller manages and the
+// CRUD methods on Client, built on opnsense.Resource:
+//
+//	opnsense-gen \
+//	  -controller core/src/opnsense/mvc/app/controllers/OPNsense/Routes/Api/RoutesController.php \
+//	  -name Route \
+//	  -out opnsense/routes.go
+//
+// The generated code uses unexported helpers of the opnsense package, so the
+// output belongs in the opnsense directory. The model definition is located
+// next to the controller in the checkout, use -model if it lives elsewhere.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+var errMissingFlag = errors.New("missing required flag")
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("opnsense-gen: ")
+
+	controllerFile := flag.String("controller", "", "path to the API controller (required)")
+	modelFile := flag.String("model", "", "path to the model XML (default: derived from the controller)")
+	name := flag.String("name", "", "prefix of the generated types and methods, e.g. FirewallCategory (required)")
+	reconfigure := flag.String("reconfigure", "", "endpoint applying changes (default: reconfigure action of the controller)")
+	out := flag.String("out", "", "output file (default: stdout)")
+
+	flag.Parse()
+
+	err := run(*controllerFile, *modelFile, *name, *reconfigure, *out)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(controllerFile, modelFile, name, reconfigure, out string) error {
+	if controllerFile == "" || name == "" {
+		return fmt.Errorf("%w: -controller and -name are required", errMissingFlag)
+	}
+
+	source, err := os.ReadFile(controllerFile)
+	if err != nil {
+		return err
+	}
+
+	ctrl, err := parseController(string(source))
+	if err != nil {
+		return err
+	}
+
+	if reconfigure != "" {
+		ctrl.Reconfigure = reconfigure
+	}
+
+	if modelFile == "" {
+		var ok bool
+
+		modelFile, ok = ctrl.modelFile(controllerFile)
+		if !ok {
+			return fmt.Errorf("%w: cannot locate model of %s, use -model", errMissingFlag, controllerFile)
+		}
+	}
+
+	f, err := os.Open(modelFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	m, err := parseModel(f)
+	if err != nil {
+		return err
+	}
+
+	array, err := m.arrayField(ctrl.ModelPath)
+	if err != nil {
+		return err
+	}
+
+	code, err := generate(binding{
+		Name:       name,
+		Source:     filepath.Base(modelFile) + " and " + filepath.Base(controllerFile),
+		Controller: ctrl,
+		Fields:     fieldsOf(*array),
+	})
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(code)
+
+		return err
+	}
+
+	return os.WriteFile(out, code, 0o600)
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var errNoArrayField = errors.New("no ArrayField found in model")
+
+// node is an element of an OPNsense model definition. Fields carry their
+// class in the type attribute, containers have none.
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []node     `xml:",any"`
+}
+
+func (n node) fieldType() string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == "type" {
+			// Custom fields are referenced relative to the model namespace,
+			// e.g. .\FilterRuleField, only the class name is of interest.
+			t := attr.Value
+			if i := strings.LastIndex(t, `\`); i >= 0 {
+				t = t[i+1:]
+			}
+
+			return t
+		}
+	}
+
+	return ""
+}
+
+type model struct {
+	Mount string
+	Items node
+}
+
+func parseModel(r io.Reader) (*model, error) {
+	var root struct {
+		Mount string `xml:"mount"`
+		Items node   `xml:"items"`
+	}
+
+	err := xml.NewDecoder(r).Decode(&root)
+	if err != nil {
+		return nil, fmt.Errorf("parsing model: %w", err)
+	}
+
+	return &model{Mount: root.Mount, Items: root.Items}, nil
+}
+
+// arrayField returns the ArrayField at the dotted path, e.g. rules.rule, or
+// the first ArrayField of the model if path is empty.
+func (m *model) arrayField(path string) (*node, error) {
+	if path == "" {
+		found := findArrayField(m.Items)
+		if found == nil {
+			return nil, errNoArrayField
+		}
+
+		return found, nil
+	}
+
+	current := m.Items
+
+	for _, name := range strings.Split(path, ".") {
+		next := -1
+
+		for i, child := range current.Nodes {
+			if child.XMLName.Local == name {
+				next = i
+
+				break
+			}
+		}
+
+		if next < 0 {
+			return nil, fmt.Errorf("%w: %s", errNoArrayField, path)
+		}
+
+		current = current.Nodes[next]
+	}
+
+	if current.fieldType() != "ArrayField" {
+		return nil, fmt.Errorf("%w: %s is a %s", errNoArrayField, path, current.fieldType())
+	}
+
+	return &current, nil
+}
+
+func findArrayField(n node) *node {
+	for _, child := range n.Nodes {
+		if child.fieldType() == "ArrayField" {
+			return &child
+		}
+
+		if child.fieldType() == "" {
+			if found := findArrayField(child); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
+
+// field is a member of a generated struct. Containers become nested structs
+// with their own fields.
+type field struct {
+	Name     string
+	JSONName string
+	GetType  string
+	SetType  string
+	Fields   []field
+}
+
+func fieldsOf(n node) []field {
+	fields := []field{}
+
+	for _, child := range n.Nodes {
+		t := child.fieldType()
+
+		f := field{
+			Name:     goName(child.XMLName.Local),
+			JSONName: child.XMLName.Local,
+		}
+
+		switch {
+		case t == "" && len(child.Nodes) > 0:
+			f.Fields = fieldsOf(child)
+		case t == "ArrayField":
+			// Nested arrays have their own endpoints in OPNsense.
+			continue
+		default:
+			f.GetType, f.SetType = goTypes(t)
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// goTypes maps a field class to the types used for reading and writing it.
+// Fields that OPNsense presents as a list of choices on read are sent back
+// as a comma separated string of the selected keys.
+func goTypes(fieldType string) (string, string) {
+	switch fieldType {
+	case "BooleanField":
+		return "Bool", "Bool"
+	case "IntegerField", "AutoNumberField":
+		return "Integer", "Integer"
+	case "PortField":
+		return "*PortRange", "*PortRange"
+	case "NetworkField", "NetworkAliasField":
+		return "NetworkOrAlias", "NetworkOrAlias"
+	case "OptionField", "InterfaceField", "ModelRelationField", "CertificateField",
+		"AuthGroupField", "AuthenticationServerField", "ConfigdActionsField",
+		"CountryField", "JsonKeyValueStoreField", "ProtocolField", "VirtualIPField",
+		"LegacyLinkField":
+		return "SelectedMap", "string"
+	}
+
+	return "string", "string"
+}
+
+// initialisms are spelled in upper case in Go identifiers.
+func initialism(word string) (string, bool) {
+	switch word {
+	case "id", "ip", "uuid", "dns", "mtu", "url", "nat", "tls", "psk", "asn", "vlan":
+		return strings.ToUpper(word), true
+	}
+
+	return "", false
+}
+
+func goName(name string) string {
+	var sb strings.Builder
+
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.'
+	}) {
+		if upper, ok := initialism(strings.ToLower(word)); ok {
+			sb.WriteString(upper)
+
+			continue
+		}
+
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,36 @@
+<?php
+
+namespace OPNsense\Firewall\Api;
+
+use OPNsense\Base\ApiMutableModelControllerBase;
+
+class CategoryController extends ApiMutableModelControllerBase
+{
+    protected static $internalModelName = 'category';
+    protected static $internalModelClass = 'OPNsense\Firewall\Category';
+
+    public function searchItemAction()
+    {
+        return $this->searchBase("categories.category", array('name', 'auto', 'color'), "name");
+    }
+
+    public function setItemAction($uuid)
+    {
+        return $this->setBase("category", "categories.category", $uuid);
+    }
+
+    public function addItemAction()
+    {
+        return $this->addBase("category", "categories.category");
+    }
+
+    public function getItemAction($uuid = null)
+    {
+        return $this->getBase("category", "categories.category", $uuid);
+    }
+
+    public function delItemAction($uuid)
+    {
+        return $this->delBase("categories.category", $uuid);
+    }
+}
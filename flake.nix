@@ -25,7 +25,7 @@
           root = ./.;
           pname = "opnsense-go";
           version = "0.0.1";
-          vendorHash = "sha256-GyJLphLsUYojxdw10m5qwuGlu1GyyWVqSUPPLtEFqgM=";
+          vendorHash = "sha256-TwyfrXdnxA5gv/j4e4mcj1uv3sXsnsKs0j94DuGtEeQ=";
           goPkg = pkgs.go_1_26;
         };
       in
@@ -1,10 +1,17 @@
 module github.com/kradalby/opnsense-go
 
-go 1.12
+go 1.23.0
 
 require (
-	github.com/gobuffalo/envy v1.9.0
-	github.com/rogpeppe/go-internal v1.5.1 // indirect
 	github.com/satori/go.uuid v1.2.0
-	github.com/stretchr/testify v1.7.0
+	github.com/stretchr/testify v1.9.0
+	go.uber.org/mock v0.6.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/kr/pretty v0.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
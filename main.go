@@ -10,7 +10,10 @@ import (
 )
 
 func main() {
-	_, err := opnsense.NewClient("http://localhost:8080", "", "", true)
+	_, err := opnsense.New(
+		opnsense.WithBaseURL("http://localhost:8080"),
+		opnsense.WithInsecureSkipVerify(true),
+	)
 	if err != nil {
 		log.Fatal(err)
 	}
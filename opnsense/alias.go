@@ -1,8 +1,9 @@
 package opnsense
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"iter"
 	"path"
 	"strings"
 
@@ -68,27 +69,34 @@ type AliasReconfigureResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) AliasGet(uuid uuid.UUID) (*AliasFormat, error) {
-	type Response struct {
-		Alias AliasGet `json:"alias"`
-	}
+func (c *Client) aliasResource() *Resource[AliasGet, AliasSet] {
+	return NewResource[AliasGet, AliasSet](c, ResourceConfig{
+		Module:      "firewall/alias",
+		Key:         "alias",
+		Suffix:      "Item",
+		Reconfigure: "firewall/alias/reconfigure",
+	})
+}
 
-	var rawResponse Response
+func (c *Client) AliasGet(uuid uuid.UUID) (*AliasFormat, error) {
+	return c.AliasGetCtx(context.Background(), uuid)
+}
 
-	err := c.GetAndUnmarshal(path.Join("firewall/alias/getItem", uuid.String()), &rawResponse)
+func (c *Client) AliasGetCtx(ctx context.Context, uuid uuid.UUID) (*AliasFormat, error) {
+	alias, err := c.aliasResource().Get(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
 	var response AliasFormat
 	response.UUID = &uuid
-	response.Enabled = rawResponse.Alias.Enabled == "1"
-	response.Name = rawResponse.Alias.Name
-	response.Description = rawResponse.Alias.Description
-	response.Updatefreq = rawResponse.Alias.Updatefreq
-	response.Counters = rawResponse.Alias.Counters
+	response.Enabled = alias.Enabled == "1"
+	response.Name = alias.Name
+	response.Description = alias.Description
+	response.Updatefreq = alias.Updatefreq
+	response.Counters = alias.Counters
 
-	for k, v := range rawResponse.Alias.Type {
+	for k, v := range alias.Type {
 		if v.Selected == 1 && k != "" {
 			response.Type = k
 
@@ -96,70 +104,60 @@ func (c *Client) AliasGet(uuid uuid.UUID) (*AliasFormat, error) {
 		}
 	}
 
-	for k, v := range rawResponse.Alias.Content {
+	for k, v := range alias.Content {
 		if v.Selected == 1 && k != "" {
 			response.Content = append(response.Content, v.Value)
 		}
 	}
 
-	return &response, err
+	return &response, nil
 }
 
 func (c *Client) AliasGetList() (*AliasList, error) {
-	var response AliasList
+	return c.AliasGetListCtx(context.Background())
+}
 
-	err := c.GetAndUnmarshal("firewall/alias/searchItem", &response)
+func (c *Client) AliasGetListCtx(ctx context.Context) (*AliasList, error) {
+	rows, err := collect(c.AliasSearchAll(ctx, SearchOptions{}))
 	if err != nil {
 		return nil, err
 	}
 
-	return &response, err
+	return &AliasList{
+		Rows:     rows,
+		RowCount: len(rows),
+		Total:    len(rows),
+		Current:  1,
+	}, nil
 }
 
-func (c *Client) AliasUpdate(uuid uuid.UUID, conf AliasFormat) (*GenericResponse, error) {
-	type Request struct {
-		Alias AliasSet `json:"alias"`
-	}
-
-	var request Request
-	request.Alias = AliasFormatToSet(conf)
+// AliasSearchAll iterates over the aliases matching opts, fetching pages as
+// they are needed.
+func (c *Client) AliasSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[AliasListItem, error] {
+	return SearchAll[AliasListItem](ctx, c, c.aliasResource().searchEndpoint(), opts)
+}
 
-	var response GenericResponse
+func (c *Client) AliasUpdate(uuid uuid.UUID, conf AliasFormat) (*GenericResponse, error) {
+	return c.AliasUpdateCtx(context.Background(), uuid, conf)
+}
 
-	err := c.PostAndMarshal(path.Join("firewall/alias/setItem", uuid.String()), request, &response)
+func (c *Client) AliasUpdateCtx(ctx context.Context, uuid uuid.UUID, conf AliasFormat) (*GenericResponse, error) {
+	response, err := c.aliasResource().Set(ctx, uuid, AliasFormatToSet(conf))
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] AliasUpdate response: %#v", response)
-
-		return nil, fmt.Errorf("AliasUpdate failed: %w", ErrOpnsenseSave)
+		return nil, fmt.Errorf("AliasUpdate failed: %w", err)
 	}
 
-	return &response, nil
+	return response, nil
 }
 
 func (c *Client) AliasAdd(conf AliasFormat) (*uuid.UUID, error) {
-	type Request struct {
-		Alias AliasSet `json:"alias"`
-	}
-
-	var request Request
-
-	request.Alias = AliasFormatToSet(conf)
-
-	var response GenericResponse
+	return c.AliasAddCtx(context.Background(), conf)
+}
 
-	err := c.PostAndMarshal("firewall/alias/addItem", request, &response)
+func (c *Client) AliasAddCtx(ctx context.Context, conf AliasFormat) (*uuid.UUID, error) {
+	response, err := c.aliasResource().Add(ctx, AliasFormatToSet(conf))
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] AliasAdd response: %#v", response)
-
-		return nil, fmt.Errorf("AliasAdd failed: %w", ErrOpnsenseSave)
+		return nil, fmt.Errorf("AliasAdd failed: %w", err)
 	}
 
 	return response.UUID, nil
@@ -186,39 +184,29 @@ func AliasFormatToSet(conf AliasFormat) AliasSet {
 }
 
 func (c *Client) AliasDelete(uuid uuid.UUID) (*GenericResponse, error) {
-	var response GenericResponse
+	return c.AliasDeleteCtx(context.Background(), uuid)
+}
 
-	err := c.PostAndMarshal(path.Join("firewall/alias/delItem", uuid.String()), nil, &response)
+func (c *Client) AliasDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error) {
+	response, err := c.aliasResource().Delete(ctx, uuid)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("AliasDelete failed: %w", err)
 	}
 
-	if response.Result != StatusDeleted {
-		log.Printf("[TRACE] AliasDelete response: %#v", response)
-
-		return nil, fmt.Errorf("AliasDelete failed: %w", ErrOpnsenseDelete)
-	}
-
-	return &response, nil
+	return response, nil
 }
 
 func (c *Client) AliasReconfigure() (*AliasReconfigureResponse, error) {
-	var response AliasReconfigureResponse
-
-	request := map[string]interface{}{}
+	return c.AliasReconfigureCtx(context.Background())
+}
 
-	err := c.PostAndMarshal("firewall/alias/reconfigure", request, &response)
+func (c *Client) AliasReconfigureCtx(ctx context.Context) (*AliasReconfigureResponse, error) {
+	err := c.aliasResource().Reconfigure(ctx)
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Status != StatusOK {
-		log.Printf("[TRACE] AliasReconfigure response: %#v", response)
-
-		return nil, fmt.Errorf("AliasReconfigure failed: %w", ErrOpnsenseStatusNotOk)
+		return nil, fmt.Errorf("AliasReconfigure failed: %w", err)
 	}
 
-	return &response, nil
+	return &AliasReconfigureResponse{Status: StatusOK}, nil
 }
 
 // ALIAS UTILS SECTION.
@@ -243,9 +231,13 @@ type AliasUtilsResponse struct {
 }
 
 func (c *Client) AliasUtilsGet(name string) (*AliasUtilsGet, error) {
+	return c.AliasUtilsGetCtx(context.Background(), name)
+}
+
+func (c *Client) AliasUtilsGetCtx(ctx context.Context, name string) (*AliasUtilsGet, error) {
 	var response AliasUtilsGet
 
-	err := c.GetAndUnmarshal(path.Join("firewall/alias_util/list", name), &response)
+	err := c.GetAndUnmarshalCtx(ctx, path.Join("firewall/alias_util/list", name), &response)
 	if err != nil {
 		return nil, err
 	}
@@ -256,15 +248,19 @@ func (c *Client) AliasUtilsGet(name string) (*AliasUtilsGet, error) {
 }
 
 func (c *Client) AliasUtilsAdd(name string, request AliasUtilsSet) (*AliasUtilsResponse, error) {
+	return c.AliasUtilsAddCtx(context.Background(), name, request)
+}
+
+func (c *Client) AliasUtilsAddCtx(ctx context.Context, name string, request AliasUtilsSet) (*AliasUtilsResponse, error) {
 	var response AliasUtilsResponse
 
-	err := c.PostAndMarshal(path.Join("firewall/alias_util/add", name), request, &response)
+	err := c.PostAndMarshalCtx(ctx, path.Join("firewall/alias_util/add", name), request, &response)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.Status != StatusDone {
-		log.Printf("[TRACE] AliasUtilsGet response: %#v", response)
+		c.logger.DebugContext(ctx, "AliasUtilsGet response", "response", response)
 
 		return nil, fmt.Errorf("AliasUtilsGet failed: %w", ErrOpnsenseDone)
 	}
@@ -273,15 +269,19 @@ func (c *Client) AliasUtilsAdd(name string, request AliasUtilsSet) (*AliasUtilsR
 }
 
 func (c *Client) AliasUtilsDel(name string, request AliasUtilsSet) (*AliasUtilsResponse, error) {
+	return c.AliasUtilsDelCtx(context.Background(), name, request)
+}
+
+func (c *Client) AliasUtilsDelCtx(ctx context.Context, name string, request AliasUtilsSet) (*AliasUtilsResponse, error) {
 	var response AliasUtilsResponse
 
-	err := c.PostAndMarshal(path.Join("firewall/alias_util/delete", name), request, &response)
+	err := c.PostAndMarshalCtx(ctx, path.Join("firewall/alias_util/delete", name), request, &response)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.Status != StatusDone {
-		log.Printf("[TRACE] AliasUtilsDel response: %#v", response)
+		c.logger.DebugContext(ctx, "AliasUtilsDel response", "response", response)
 
 		return nil, fmt.Errorf("AliasUtilsDel failed: %w", ErrOpnsenseDone)
 	}
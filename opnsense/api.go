@@ -0,0 +1,313 @@
+package opnsense
+
+import (
+	"context"
+	"iter"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=api.go -destination=mocks/api.go -package=mocks
+
+// The interfaces below group the methods of Client by the part of OPNsense
+// they manage, so code using the client can depend on the parts it needs and
+// be tested against the mocks in the mocks package.
+
+// AliasAPI manages firewall aliases and their content.
+type AliasAPI interface {
+	AliasGet(uuid uuid.UUID) (*AliasFormat, error)
+	AliasGetCtx(ctx context.Context, uuid uuid.UUID) (*AliasFormat, error)
+	AliasGetList() (*AliasList, error)
+	AliasGetListCtx(ctx context.Context) (*AliasList, error)
+	AliasSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[AliasListItem, error]
+	AliasUpdate(uuid uuid.UUID, conf AliasFormat) (*GenericResponse, error)
+	AliasUpdateCtx(ctx context.Context, uuid uuid.UUID, conf AliasFormat) (*GenericResponse, error)
+	AliasAdd(conf AliasFormat) (*uuid.UUID, error)
+	AliasAddCtx(ctx context.Context, conf AliasFormat) (*uuid.UUID, error)
+	AliasDelete(uuid uuid.UUID) (*GenericResponse, error)
+	AliasDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error)
+	AliasReconfigure() (*AliasReconfigureResponse, error)
+	AliasReconfigureCtx(ctx context.Context) (*AliasReconfigureResponse, error)
+	AliasUtilsGet(name string) (*AliasUtilsGet, error)
+	AliasUtilsGetCtx(ctx context.Context, name string) (*AliasUtilsGet, error)
+	AliasUtilsAdd(name string, request AliasUtilsSet) (*AliasUtilsResponse, error)
+	AliasUtilsAddCtx(ctx context.Context, name string, request AliasUtilsSet) (*AliasUtilsResponse, error)
+	AliasUtilsDel(name string, request AliasUtilsSet) (*AliasUtilsResponse, error)
+	AliasUtilsDelCtx(ctx context.Context, name string, request AliasUtilsSet) (*AliasUtilsResponse, error)
+}
+
+// FirewallFilterAPI manages filter rules and applies them with rollback.
+type FirewallFilterAPI interface {
+	FirewallFilterApply(rollbackRevision *string) error
+	FirewallFilterApplyCtx(ctx context.Context, rollbackRevision *string) error
+	FirewallFilterCancelRollback(rollbackRevision string) (*GenericResponse, error)
+	FirewallFilterCancelRollbackCtx(ctx context.Context, rollbackRevision string) (*GenericResponse, error)
+	FirewallFilterRevert(revision string) (*GenericResponse, error)
+	FirewallFilterRevertCtx(ctx context.Context, revision string) (*GenericResponse, error)
+	FirewallFilterSavepoint() (*Savepoint, error)
+	FirewallFilterSavepointCtx(ctx context.Context) (*Savepoint, error)
+	FirewallFilterTransaction(stage func(ctx context.Context) error, check FirewallFilterCheck) error
+	FirewallFilterTransactionCtx(ctx context.Context, stage func(ctx context.Context) error, check FirewallFilterCheck) error
+	FirewallFilterRuleGet(uuid uuid.UUID) (*FilterRule, error)
+	FirewallFilterRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*FilterRule, error)
+	FirewallFilterRuleSet(rule *FilterRule) error
+	FirewallFilterRuleSetCtx(ctx context.Context, rule *FilterRule) error
+	FirewallFilterRuleAdd(rule *FilterRule) (*uuid.UUID, error)
+	FirewallFilterRuleAddCtx(ctx context.Context, rule *FilterRule) (*uuid.UUID, error)
+	FirewallFilterRuleDelete(uuid uuid.UUID) error
+	FirewallFilterRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+	FirewallFilterRuleSearch() ([]*FilterRule, error)
+	FirewallFilterRuleSearchCtx(ctx context.Context) ([]*FilterRule, error)
+	FirewallFilterRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*FilterRule, error]
+	FirewallFilterRuleFindByDescription(description string) ([]*FilterRule, error)
+	FirewallFilterRuleFindByDescriptionCtx(ctx context.Context, description string) ([]*FilterRule, error)
+	FirewallFilterRuleFindByCategory(category uuid.UUID) ([]*FilterRule, error)
+	FirewallFilterRuleFindByCategoryCtx(ctx context.Context, category uuid.UUID) ([]*FilterRule, error)
+	FirewallFilterRuleDeleteByCategory(category uuid.UUID) ([]uuid.UUID, error)
+	FirewallFilterRuleDeleteByCategoryCtx(ctx context.Context, category uuid.UUID) ([]uuid.UUID, error)
+	FirewallFilterRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallFilterRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallFilterRuleMoveBefore(id uuid.UUID, anchor uuid.UUID) error
+	FirewallFilterRuleMoveBeforeCtx(ctx context.Context, id uuid.UUID, anchor uuid.UUID) error
+	FirewallFilterRulesReorder(order []uuid.UUID) error
+	FirewallFilterRulesReorderCtx(ctx context.Context, order []uuid.UUID) error
+	FirewallFilterRulePlan(scope FilterRuleScope, desired []FilterRule) (*FilterRulePlan, error)
+	FirewallFilterRulePlanCtx(ctx context.Context, scope FilterRuleScope, desired []FilterRule) (*FilterRulePlan, error)
+	FirewallFilterRulePlanApply(plan *FilterRulePlan, check FirewallFilterCheck) error
+	FirewallFilterRulePlanApplyCtx(ctx context.Context, plan *FilterRulePlan, check FirewallFilterCheck) error
+	FirewallFilterRuleReconcile(scope FilterRuleScope, desired []FilterRule, check FirewallFilterCheck) (*FilterRulePlan, error)
+	FirewallFilterRuleReconcileCtx(
+		ctx context.Context,
+		scope FilterRuleScope,
+		desired []FilterRule,
+		check FirewallFilterCheck,
+	) (*FilterRulePlan, error)
+}
+
+// FirewallCategoryAPI manages the categories firewall rules and aliases are
+// grouped by.
+type FirewallCategoryAPI interface {
+	FirewallCategoryGet(uuid uuid.UUID) (*Category, error)
+	FirewallCategoryGetCtx(ctx context.Context, uuid uuid.UUID) (*Category, error)
+	FirewallCategorySearch() ([]*Category, error)
+	FirewallCategorySearchCtx(ctx context.Context) ([]*Category, error)
+	FirewallCategorySearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*Category, error]
+	FirewallCategoryFindByName(name string) (*Category, error)
+	FirewallCategoryFindByNameCtx(ctx context.Context, name string) (*Category, error)
+	FirewallCategoryAdd(category *Category) (*uuid.UUID, error)
+	FirewallCategoryAddCtx(ctx context.Context, category *Category) (*uuid.UUID, error)
+	FirewallCategorySet(category *Category) error
+	FirewallCategorySetCtx(ctx context.Context, category *Category) error
+	FirewallCategoryDelete(uuid uuid.UUID) error
+	FirewallCategoryDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+}
+
+// InterfacesAPI lists the assigned interfaces and manages interface groups.
+type InterfacesAPI interface {
+	InterfacesList() ([]*InterfaceInfo, error)
+	InterfacesListCtx(ctx context.Context) ([]*InterfaceInfo, error)
+	InterfacesValidate(iface Interface) error
+	InterfacesValidateCtx(ctx context.Context, iface Interface) error
+	InterfaceGroupGet(uuid uuid.UUID) (*InterfaceGroup, error)
+	InterfaceGroupGetCtx(ctx context.Context, uuid uuid.UUID) (*InterfaceGroup, error)
+	InterfaceGroupSearch() ([]*InterfaceGroup, error)
+	InterfaceGroupSearchCtx(ctx context.Context) ([]*InterfaceGroup, error)
+	InterfaceGroupSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*InterfaceGroup, error]
+	InterfaceGroupAdd(group *InterfaceGroup) (*uuid.UUID, error)
+	InterfaceGroupAddCtx(ctx context.Context, group *InterfaceGroup) (*uuid.UUID, error)
+	InterfaceGroupSet(group *InterfaceGroup) error
+	InterfaceGroupSetCtx(ctx context.Context, group *InterfaceGroup) error
+	InterfaceGroupDelete(uuid uuid.UUID) error
+	InterfaceGroupDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+	InterfaceGroupReconfigure() error
+	InterfaceGroupReconfigureCtx(ctx context.Context) error
+}
+
+// FirewallNatAPI manages NAT rules. They are part of the filter
+// configuration: changes are made live by FirewallFilterApply and can be
+// staged in a FirewallFilterTransaction together with filter rules. Like
+// their filter rule counterparts, Add sets the UUID of the rule it is given
+// and SearchAll fetches pages as they are needed.
+type FirewallNatAPI interface {
+	FirewallSourceNatRuleGet(uuid uuid.UUID) (*SourceNatRule, error)
+	FirewallSourceNatRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*SourceNatRule, error)
+	FirewallSourceNatRuleSearch() ([]*SourceNatRule, error)
+	FirewallSourceNatRuleSearchCtx(ctx context.Context) ([]*SourceNatRule, error)
+	FirewallSourceNatRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*SourceNatRule, error]
+	FirewallSourceNatRuleAdd(rule *SourceNatRule) (*uuid.UUID, error)
+	FirewallSourceNatRuleAddCtx(ctx context.Context, rule *SourceNatRule) (*uuid.UUID, error)
+	FirewallSourceNatRuleSet(rule *SourceNatRule) error
+	FirewallSourceNatRuleSetCtx(ctx context.Context, rule *SourceNatRule) error
+	FirewallSourceNatRuleDelete(uuid uuid.UUID) error
+	FirewallSourceNatRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+	FirewallSourceNatRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallSourceNatRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallDestinationNatRuleGet(uuid uuid.UUID) (*DestinationNatRule, error)
+	FirewallDestinationNatRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*DestinationNatRule, error)
+	FirewallDestinationNatRuleSearch() ([]*DestinationNatRule, error)
+	FirewallDestinationNatRuleSearchCtx(ctx context.Context) ([]*DestinationNatRule, error)
+	FirewallDestinationNatRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*DestinationNatRule, error]
+	FirewallDestinationNatRuleAdd(rule *DestinationNatRule) (*uuid.UUID, error)
+	FirewallDestinationNatRuleAddCtx(ctx context.Context, rule *DestinationNatRule) (*uuid.UUID, error)
+	FirewallDestinationNatRuleAddWithFilterRule(rule *DestinationNatRule) (*FilterRule, error)
+	FirewallDestinationNatRuleAddWithFilterRuleCtx(ctx context.Context, rule *DestinationNatRule) (*FilterRule, error)
+	FirewallDestinationNatRuleSet(rule *DestinationNatRule) error
+	FirewallDestinationNatRuleSetCtx(ctx context.Context, rule *DestinationNatRule) error
+	FirewallDestinationNatRuleDelete(uuid uuid.UUID) error
+	FirewallDestinationNatRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+	FirewallDestinationNatRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallDestinationNatRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallOneToOneRuleGet(uuid uuid.UUID) (*OneToOneRule, error)
+	FirewallOneToOneRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*OneToOneRule, error)
+	FirewallOneToOneRuleSearch() ([]*OneToOneRule, error)
+	FirewallOneToOneRuleSearchCtx(ctx context.Context) ([]*OneToOneRule, error)
+	FirewallOneToOneRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*OneToOneRule, error]
+	FirewallOneToOneRuleAdd(rule *OneToOneRule) (*uuid.UUID, error)
+	FirewallOneToOneRuleAddCtx(ctx context.Context, rule *OneToOneRule) (*uuid.UUID, error)
+	FirewallOneToOneRuleSet(rule *OneToOneRule) error
+	FirewallOneToOneRuleSetCtx(ctx context.Context, rule *OneToOneRule) error
+	FirewallOneToOneRuleDelete(uuid uuid.UUID) error
+	FirewallOneToOneRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+	FirewallOneToOneRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallOneToOneRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallNptRuleGet(uuid uuid.UUID) (*NptRule, error)
+	FirewallNptRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*NptRule, error)
+	FirewallNptRuleSearch() ([]*NptRule, error)
+	FirewallNptRuleSearchCtx(ctx context.Context) ([]*NptRule, error)
+	FirewallNptRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*NptRule, error]
+	FirewallNptRuleAdd(rule *NptRule) (*uuid.UUID, error)
+	FirewallNptRuleAddCtx(ctx context.Context, rule *NptRule) (*uuid.UUID, error)
+	FirewallNptRuleSet(rule *NptRule) error
+	FirewallNptRuleSetCtx(ctx context.Context, rule *NptRule) error
+	FirewallNptRuleDelete(uuid uuid.UUID) error
+	FirewallNptRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error
+	FirewallNptRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+	FirewallNptRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error)
+}
+
+// BgpAPI manages the BGP neighbors of os-frr.
+type BgpAPI interface {
+	BgpNeighborGet(uuid uuid.UUID) (*BgpNeighborGet, error)
+	BgpNeighborGetCtx(ctx context.Context, uuid uuid.UUID) (*BgpNeighborGet, error)
+	BgpNeighborGetUUIDs() ([]*uuid.UUID, error)
+	BgpNeighborGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error)
+	BgpNeighborList() ([]*BgpNeighborGet, error)
+	BgpNeighborListCtx(ctx context.Context) ([]*BgpNeighborGet, error)
+	BgpNeighborSet(uuid uuid.UUID, clientConf BgpNeighborSet) (*GenericResponse, error)
+	BgpNeighborSetCtx(ctx context.Context, uuid uuid.UUID, clientConf BgpNeighborSet) (*GenericResponse, error)
+	BgpNeighborAdd(clientConf BgpNeighborSet) (*uuid.UUID, error)
+	BgpNeighborAddCtx(ctx context.Context, clientConf BgpNeighborSet) (*uuid.UUID, error)
+	BgpNeighborDelete(uuid uuid.UUID) (*GenericResponse, error)
+	BgpNeighborDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error)
+}
+
+// WireGuardAPI manages the os-wireguard service, its servers and clients.
+type WireGuardAPI interface {
+	WireGuardRestart() (*GenericResponse, error)
+	WireGuardRestartCtx(ctx context.Context) (*GenericResponse, error)
+	WireGuardStart() (*GenericResponse, error)
+	WireGuardStartCtx(ctx context.Context) (*GenericResponse, error)
+	WireGuardStop() (*GenericResponse, error)
+	WireGuardStopCtx(ctx context.Context) (*GenericResponse, error)
+	WireGuardShowConfig() (*GenericResponse, error)
+	WireGuardShowConfigCtx(ctx context.Context) (*GenericResponse, error)
+	WireGuardShowHandshake() (*GenericResponse, error)
+	WireGuardShowHandshakeCtx(ctx context.Context) (*GenericResponse, error)
+	WireGuardSettingsGet() (*WireGuardSettings, error)
+	WireGuardSettingsGetCtx(ctx context.Context) (*WireGuardSettings, error)
+	WireGuardSettingsSet(settings WireGuardSettings) (*GenericResponse, error)
+	WireGuardSettingsSetCtx(ctx context.Context, settings WireGuardSettings) (*GenericResponse, error)
+	WireGuardEnableService() error
+	WireGuardEnableServiceCtx(ctx context.Context) error
+	WireGuardDisableService() error
+	WireGuardDisableServiceCtx(ctx context.Context) error
+	WireGuardClientGet(uuid uuid.UUID) (*WireGuardClientGet, error)
+	WireGuardClientGetCtx(ctx context.Context, uuid uuid.UUID) (*WireGuardClientGet, error)
+	WireGuardClientGetUUIDs() ([]*uuid.UUID, error)
+	WireGuardClientGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error)
+	WireGuardClientList() ([]*WireGuardClientGet, error)
+	WireGuardClientListCtx(ctx context.Context) ([]*WireGuardClientGet, error)
+	WireGuardClientSet(uuid uuid.UUID, clientConf WireGuardClientSet) (*GenericResponse, error)
+	WireGuardClientSetCtx(ctx context.Context, uuid uuid.UUID, clientConf WireGuardClientSet) (*GenericResponse, error)
+	WireGuardClientAdd(clientConf WireGuardClientSet) (*uuid.UUID, error)
+	WireGuardClientAddCtx(ctx context.Context, clientConf WireGuardClientSet) (*uuid.UUID, error)
+	WireGuardClientDelete(uuid uuid.UUID) (*GenericResponse, error)
+	WireGuardClientDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error)
+	WireGuardServerGet(uuid uuid.UUID) (*WireGuardServerGet, error)
+	WireGuardServerGetCtx(ctx context.Context, uuid uuid.UUID) (*WireGuardServerGet, error)
+	WireGuardServerGetUUIDs() ([]*uuid.UUID, error)
+	WireGuardServerGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error)
+	WireGuardServerFindUUIDByName(name string) ([]*uuid.UUID, error)
+	WireGuardServerFindUUIDByNameCtx(ctx context.Context, name string) ([]*uuid.UUID, error)
+	WireGuardServerList() ([]*WireGuardServerGet, error)
+	WireGuardServerListCtx(ctx context.Context) ([]*WireGuardServerGet, error)
+	WireGuardServerSet(uuid uuid.UUID, serverConf WireGuardServerSet) (*GenericResponse, error)
+	WireGuardServerSetCtx(ctx context.Context, uuid uuid.UUID, serverConf WireGuardServerSet) (*GenericResponse, error)
+	WireGuardServerAdd(serverConf WireGuardServerSet) (*uuid.UUID, error)
+	WireGuardServerAddCtx(ctx context.Context, serverConf WireGuardServerSet) (*uuid.UUID, error)
+	WireGuardServerDelete(uuid uuid.UUID) (*GenericResponse, error)
+	WireGuardServerDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error)
+}
+
+// FirmwareAPI manages firmware, packages and power state.
+type FirmwareAPI interface {
+	PowerOff() (*StatusMessage, error)
+	PowerOffCtx(ctx context.Context) (*StatusMessage, error)
+	Reboot() (*StatusMessage, error)
+	RebootCtx(ctx context.Context) (*StatusMessage, error)
+	Upgrade() (*StatusMessage, error)
+	UpgradeCtx(ctx context.Context) (*StatusMessage, error)
+	UpgradeStatus() (*UpgradeStatusMessage, error)
+	UpgradeStatusCtx(ctx context.Context) (*UpgradeStatusMessage, error)
+	Audit() (*StatusMessage, error)
+	AuditCtx(ctx context.Context) (*StatusMessage, error)
+	FirmwareConfigGet() (*FirmwareConfig, error)
+	FirmwareConfigGetCtx(ctx context.Context) (*FirmwareConfig, error)
+	FirmwareConfigSet(config FirmwareConfig) (*StatusMessage, error)
+	FirmwareConfigSetCtx(ctx context.Context, config FirmwareConfig) (*StatusMessage, error)
+	FirmwareOptionsGet() (*FirmwareOptions, error)
+	FirmwareOptionsGetCtx(ctx context.Context) (*FirmwareOptions, error)
+	FirmwareStatus() (*Status, error)
+	FirmwareStatusCtx(ctx context.Context) (*Status, error)
+	FirmwareUpgradeStatus() (*UpgradeStatus, error)
+	FirmwareUpgradeStatusCtx(ctx context.Context) (*UpgradeStatus, error)
+	FirmwareInformation() (*Information, error)
+	FirmwareInformationCtx(ctx context.Context) (*Information, error)
+	FirmwareInstalledPluginsList() ([]Package, error)
+	FirmwareInstalledPluginsListCtx(ctx context.Context) ([]Package, error)
+	FirmwareInstall(packageName string) error
+	FirmwareInstallCtx(ctx context.Context, packageName string) error
+	FirmwareReInstall(packageName string) error
+	FirmwareReInstallCtx(ctx context.Context, packageName string) error
+	FirmwareRemove(packageName string) error
+	FirmwareRemoveCtx(ctx context.Context, packageName string) error
+	FirmwareLock(packageName string) error
+	FirmwareLockCtx(ctx context.Context, packageName string) error
+	FirmwareUnlock(packageName string) error
+	FirmwareUnlockCtx(ctx context.Context, packageName string) error
+	FirmwareDetails(packageName string) (*StatusMessage, error)
+	FirmwareDetailsCtx(ctx context.Context, packageName string) (*StatusMessage, error)
+	FirmwareLicense(packageName string) (*StatusMessage, error)
+	FirmwareLicenseCtx(ctx context.Context, packageName string) (*StatusMessage, error)
+}
+
+// BackupAPI downloads configuration backups.
+type BackupAPI interface {
+	Backup() (string, error)
+	BackupCtx(ctx context.Context) (string, error)
+}
+
+// API is everything Client implements.
+type API interface {
+	AliasAPI
+	FirewallFilterAPI
+	FirewallCategoryAPI
+	FirewallNatAPI
+	InterfacesAPI
+	BgpAPI
+	WireGuardAPI
+	FirmwareAPI
+	BackupAPI
+}
+
+var _ API = (*Client)(nil)
@@ -1,26 +1,21 @@
 package opnsense
 
 import (
-	"io"
-	"log"
+	"context"
+	"net/http"
 )
 
 // Requires: os-api-backup.
 func (c *Client) Backup() (string, error) {
-	api := "backup/backup/download"
-
-	resp, err := c.Get(api)
-	if err != nil {
-		log.Printf("[ERROR] Failed to download backup: %#v", err)
-
-		return "", err
-	}
+	return c.BackupCtx(context.Background())
+}
 
-	defer func() { _ = resp.Body.Close() }()
+func (c *Client) BackupCtx(ctx context.Context) (string, error) {
+	api := "backup/backup/download"
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.do(ctx, http.MethodGet, api, nil)
 	if err != nil {
-		log.Printf("[ERROR] Failed to read GET response: %#v\n", err)
+		c.logger.ErrorContext(ctx, "failed to download backup", "error", err)
 
 		return "", err
 	}
@@ -1,9 +1,8 @@
 package opnsense
 
 import (
+	"context"
 	"fmt"
-	"log"
-	"path"
 
 	uuid "github.com/satori/go.uuid"
 )
@@ -75,134 +74,78 @@ type Routemaps struct {
 	Routemap []interface{} `json:"routemap"`
 }
 
-func (c *Client) BgpNeighborGet(uuid uuid.UUID) (*BgpNeighborGet, error) {
-	api := path.Join("quagga/bgp/getNeighbor", uuid.String())
-
-	type Response struct {
-		Neighbor BgpNeighborGet `json:"neighbor"`
-	}
-
-	var response Response
+func (n *BgpNeighborBase) setUUID(id uuid.UUID) {
+	n.UUID = &id
+}
 
-	err := c.GetAndUnmarshal(api, &response)
+func (c *Client) bgpNeighborResource() *Resource[BgpNeighborGet, BgpNeighborSet] {
+	return NewResource[BgpNeighborGet, BgpNeighborSet](c, ResourceConfig{
+		Module:      "quagga/bgp",
+		Key:         "neighbor",
+		Suffix:      "Neighbor",
+		Reconfigure: "quagga/service/reconfigure",
+	})
+}
 
-	// UUID does not exist in the JSON, so we add it since we know it.
-	response.Neighbor.UUID = &uuid
+func (c *Client) BgpNeighborGet(uuid uuid.UUID) (*BgpNeighborGet, error) {
+	return c.BgpNeighborGetCtx(context.Background(), uuid)
+}
 
-	return &response.Neighbor, err
+func (c *Client) BgpNeighborGetCtx(ctx context.Context, uuid uuid.UUID) (*BgpNeighborGet, error) {
+	return c.bgpNeighborResource().Get(ctx, uuid)
 }
 
 func (c *Client) BgpNeighborGetUUIDs() ([]*uuid.UUID, error) {
-	api := "quagga/bgp/searchNeighbor"
-
-	var response SearchResult
-
-	err := c.GetAndUnmarshal(api, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	uuids := []*uuid.UUID{}
-
-	for _, row := range response.Rows {
-		m, ok := row.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		id, ok := m["uuid"].(string)
-		if !ok {
-			continue
-		}
-
-		uuid, err := uuid.FromString(id)
-		if err == nil {
-			uuids = append(uuids, &uuid)
-		}
-	}
+	return c.BgpNeighborGetUUIDsCtx(context.Background())
+}
 
-	return uuids, err
+func (c *Client) BgpNeighborGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	return c.bgpNeighborResource().UUIDs(ctx)
 }
 
 func (c *Client) BgpNeighborList() ([]*BgpNeighborGet, error) {
-	uuids, err := c.BgpNeighborGetUUIDs()
-	if err != nil {
-		return nil, err
-	}
-
-	clients := []*BgpNeighborGet{}
-
-	for _, uuid := range uuids {
-		client, err := c.BgpNeighborGet(*uuid)
-		if err == nil {
-			clients = append(clients, client)
-		}
-	}
+	return c.BgpNeighborListCtx(context.Background())
+}
 
-	return clients, nil
+func (c *Client) BgpNeighborListCtx(ctx context.Context) ([]*BgpNeighborGet, error) {
+	return c.bgpNeighborResource().List(ctx)
 }
 
 func (c *Client) BgpNeighborSet(uuid uuid.UUID, clientConf BgpNeighborSet) (*GenericResponse, error) {
-	api := path.Join("quagga/bgp/setNeighbor", uuid.String())
-
-	request := map[string]interface{}{
-		"neighbor": clientConf,
-	}
-
-	var response GenericResponse
+	return c.BgpNeighborSetCtx(context.Background(), uuid, clientConf)
+}
 
-	err := c.PostAndMarshal(api, request, &response)
+func (c *Client) BgpNeighborSetCtx(ctx context.Context, uuid uuid.UUID, clientConf BgpNeighborSet) (*GenericResponse, error) {
+	response, err := c.bgpNeighborResource().Set(ctx, uuid, clientConf)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("BgpNeighborSet failed: %w", err)
 	}
 
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] BgpNeighborSet response: %#v", response)
-
-		return nil, fmt.Errorf("BgpNeighborSet failed: %w", ErrOpnsenseSave)
-	}
-
-	return &response, nil
+	return response, nil
 }
 
 func (c *Client) BgpNeighborAdd(clientConf BgpNeighborSet) (*uuid.UUID, error) {
-	api := "quagga/bgp/addNeighbor"
-
-	request := map[string]interface{}{
-		"neighbor": clientConf,
-	}
-
-	var response GenericResponse
+	return c.BgpNeighborAddCtx(context.Background(), clientConf)
+}
 
-	err := c.PostAndMarshal(api, request, &response)
+func (c *Client) BgpNeighborAddCtx(ctx context.Context, clientConf BgpNeighborSet) (*uuid.UUID, error) {
+	response, err := c.bgpNeighborResource().Add(ctx, clientConf)
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Result != StatusDeleted {
-		log.Printf("[TRACE] BgpNeighborAdd response: %#v", response)
-
-		return nil, fmt.Errorf("BgpNeighborAdd failed: %w", ErrOpnsenseSave)
+		return nil, fmt.Errorf("BgpNeighborAdd failed: %w", err)
 	}
 
 	return response.UUID, nil
 }
 
 func (c *Client) BgpNeighborDelete(uuid uuid.UUID) (*GenericResponse, error) {
-	api := path.Join("quagga/bgp/delNeighbor", uuid.String())
-
-	var response GenericResponse
+	return c.BgpNeighborDeleteCtx(context.Background(), uuid)
+}
 
-	err := c.PostAndMarshal(api, nil, &response)
+func (c *Client) BgpNeighborDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error) {
+	response, err := c.bgpNeighborResource().Delete(ctx, uuid)
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Result != StatusDeleted {
-		log.Printf("[TRACE] BgpNeighborDelete response: %#v", response)
-
-		return nil, fmt.Errorf("BgpNeighborDelete failed: %w", ErrOpnsenseDelete)
+		return nil, fmt.Errorf("BgpNeighborDelete failed: %w", err)
 	}
 
-	return &response, nil
+	return response, nil
 }
@@ -3,114 +3,78 @@ package opnsense
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
-	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"time"
 
-	"github.com/gobuffalo/envy"
 	uuid "github.com/satori/go.uuid"
 )
 
 type Client struct {
-	baseURL *url.URL
-	key     string
-	secret  string
-	c       *http.Client
+	baseURL   *url.URL
+	key       string
+	secret    string
+	userAgent string
+	logger    *slog.Logger
+	retry     RetryPolicy
+	limiter   *rateLimiter
+	inFlight  chan struct{}
+	c         *http.Client
+
+	validateInterfaces bool
 }
 
+// NewClient creates a client where the OPNSENSE_URL, OPNSENSE_KEY,
+// OPNSENSE_SECRET and OPNSENSE_ALLOW_UNVERIFIED_TLS environment variables
+// take precedence over the arguments. Unlike New it accepts an empty base
+// URL. A .env file in the working directory is no longer loaded, the
+// variables have to be set in the environment of the process.
+//
+// Deprecated: Use New, adding FromEnv if the environment should be read.
 func NewClient(baseURL, key, secret string, insecureSkipVerify bool) (*Client, error) {
-	log.Printf(
-		"[TRACE] Creating new OPNsense client with url: %s, key: %s, secret: %s, insecure: %t",
-		baseURL,
-		key,
-		secret,
-		insecureSkipVerify,
-	)
-
-	allowInsecure := getEnvAsBool("OPNSENSE_ALLOW_UNVERIFIED_TLS", insecureSkipVerify)
-
-	httpClient := &http.Client{
-		Timeout: 60 * time.Second,
-		Transport: &http.Transport{
-			/* #nosec */
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: allowInsecure,
-			},
+	return New(
+		WithBaseURL(baseURL),
+		WithCredentials(key, secret),
+		WithInsecureSkipVerify(insecureSkipVerify),
+		FromEnv(),
+		func(cfg *clientConfig) error {
+			cfg.allowEmptyBaseURL = true
+
+			return nil
 		},
-	}
-
-	url, err := url.Parse(envy.Get("OPNSENSE_URL", baseURL))
-	if err != nil {
-		return nil, err
-	}
-
-	log.Printf("[TRACE] Parsed URL: %s", url.String())
-
-	client := &Client{
-		baseURL: url,
-		key:     envy.Get("OPNSENSE_KEY", key),
-		secret:  envy.Get("OPNSENSE_SECRET", secret),
-		c:       httpClient,
-	}
+	)
+}
 
-	log.Printf("[TRACE] Finished setting up client: %#v", client)
+// Every request method on Client comes in two flavours: a context-aware
+// variant with the Ctx suffix and a plain variant that calls it with
+// context.Background(). Deadlines and cancellation on the context are
+// honoured in addition to the timeout of the underlying http.Client.
 
-	return client, nil
+func (c *Client) Get(api string) (*http.Response, error) {
+	return c.GetCtx(context.Background(), api)
 }
 
-func (c *Client) Get(api string) (resp *http.Response, err error) {
-	// url := path.Join(c.baseURL.String(), api)
-	url := c.baseURL.String() + "/api/" + api
-	log.Printf("[TRACE] GET to %s", url)
-
-	request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, url, nil)
+func (c *Client) GetCtx(ctx context.Context, api string) (*http.Response, error) {
+	request, err := c.newRequest(ctx, http.MethodGet, api, nil)
 	if err != nil {
-		log.Printf("[ERROR] Failed to create GET request: %#v\n\n", err)
-
 		return nil, err
 	}
 
-	// request.Header.Set("Content-Type", "application/json")
-	request.SetBasicAuth(c.key, c.secret)
-
-	return c.c.Do(request)
+	return c.send(request)
 }
 
 func (c *Client) GetAndUnmarshal(api string, responseData interface{}) error {
-	resp, err := c.Get(api)
-	if err != nil {
-		log.Printf("[ERROR] Failed to GET request: %s\n", err)
-
-		return err
-	}
-
-	defer func() { _ = resp.Body.Close() }()
+	return c.GetAndUnmarshalCtx(context.Background(), api, responseData)
+}
 
-	body, err := io.ReadAll(resp.Body)
+func (c *Client) GetAndUnmarshalCtx(ctx context.Context, api string, responseData interface{}) error {
+	body, err := c.do(ctx, http.MethodGet, api, nil)
 	if err != nil {
-		log.Printf("[ERROR] Failed to read GET response: %#v\n", err)
-
 		return err
 	}
 
-	// add possible internal error from the OPNSense API
-	if resp.StatusCode == http.StatusInternalServerError {
-		log.Printf("[ERROR] Internal Error status code received: %#v\n", string(body))
-
-		return fmt.Errorf("GetAndUnmarshal failed: %w", ErrOpnsense500)
-	}
-
-	if resp.StatusCode == http.StatusUnauthorized {
-		log.Printf("[ERROR] Failed to authenticate: %#v\n", string(body))
-
-		return fmt.Errorf("GetAndUnmarshal failed: %w", ErrOpnsense401)
-	}
-
 	// The OPNsense API does not return 404 when you fetch something that does
 	// not exist, but returns an empty list instead. Check for the empty list
 	// and return a 404 error instead so implmenters could handle that error
@@ -120,12 +84,8 @@ func (c *Client) GetAndUnmarshal(api string, responseData interface{}) error {
 	}
 
 	err = json.Unmarshal(body, responseData)
-
-	log.Printf("[TRACE] Response for URL: %s\n", api)
-	log.Printf("[TRACE] Response body: %s\n", string(body))
-
 	if err != nil {
-		log.Printf("[ERROR] Failed to unmarshal GET response: %#v\n", err)
+		c.logger.ErrorContext(ctx, "failed to unmarshal GET response", "api", api, "error", err)
 
 		return err
 	}
@@ -133,67 +93,134 @@ func (c *Client) GetAndUnmarshal(api string, responseData interface{}) error {
 	return nil
 }
 
-func (c *Client) Post(api string, body io.Reader) (resp *http.Response, err error) {
-	// url := path.Join(c.baseURL.String(), api)
-	url := c.baseURL.String() + "/api/" + api
-	log.Printf("[TRACE] POST to %s", url)
+func (c *Client) Post(api string, body io.Reader) (*http.Response, error) {
+	return c.PostCtx(context.Background(), api, body)
+}
 
-	request, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, url, body)
+func (c *Client) PostCtx(ctx context.Context, api string, body io.Reader) (*http.Response, error) {
+	request, err := c.newRequest(ctx, http.MethodPost, api, body)
 	if err != nil {
-		log.Printf("[ERROR] Failed to create POST request: %#v\n", err)
-
 		return nil, err
 	}
 
-	request.SetBasicAuth(c.key, c.secret)
 	request.Header.Set("Content-Type", "application/json")
 
-	return c.c.Do(request)
+	return c.send(request)
 }
 
 func (c *Client) PostAndMarshal(api string, requestData interface{}, responseData interface{}) error {
+	return c.PostAndMarshalCtx(context.Background(), api, requestData, responseData)
+}
+
+func (c *Client) PostAndMarshalCtx(
+	ctx context.Context,
+	api string,
+	requestData interface{},
+	responseData interface{},
+) error {
 	requestBody, err := json.Marshal(requestData)
 	if err != nil {
-		log.Printf("[ERROR] Failed to marshal requestData for POST request: %#v\n", err)
+		c.logger.ErrorContext(ctx, "failed to marshal POST request", "api", api, "error", err)
 
 		return err
 	}
 
-	log.Printf("[TRACE] Request payload: %s", string(requestBody))
+	body, err := c.do(ctx, http.MethodPost, api, requestBody)
+	if err != nil {
+		return err
+	}
 
-	resp, err := c.Post(api, bytes.NewBuffer(requestBody))
+	err = json.Unmarshal(body, responseData)
 	if err != nil {
-		log.Printf("[ERROR] Failed to POST request: %#v\n", err)
+		c.logger.ErrorContext(ctx, "failed to unmarshal POST response", "api", api, "error", err, "body", string(body))
 
 		return err
 	}
 
+	return nil
+}
+
+// do sends a request and returns the body of the response if the status
+// code signals success. All other responses are turned into an APIError by
+// checkResponse. Transient failures are retried according to the
+// RetryPolicy of the client.
+func (c *Client) do(ctx context.Context, method, api string, payload []byte) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		body, err := c.doOnce(ctx, method, api, payload)
+		if err == nil || attempt >= c.retry.MaxAttempts || !c.retry.retryable(ctx, method, api, err) {
+			return body, err
+		}
+
+		backoff := c.retry.backoff(attempt)
+
+		c.logger.WarnContext(ctx, "retrying request",
+			"method", method, "api", api, "attempt", attempt, "backoff", backoff, "error", err)
+
+		err = sleepCtx(ctx, backoff)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) doOnce(ctx context.Context, method, api string, payload []byte) ([]byte, error) {
+	var resp *http.Response
+
+	var err error
+
+	if method == http.MethodPost {
+		c.logger.DebugContext(ctx, "request payload", "api", api, "body", string(payload))
+
+		resp, err = c.PostCtx(ctx, api, bytes.NewReader(payload))
+	} else {
+		resp, err = c.GetCtx(ctx, api)
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to send request", "method", method, "api", api, "error", err)
+
+		return nil, err
+	}
+
 	defer func() { _ = resp.Body.Close() }()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		log.Printf("[ERROR] Failed to read POST response: %#v\n", err)
+		c.logger.ErrorContext(ctx, "failed to read response", "method", method, "api", api, "error", err)
 
-		return err
+		return nil, err
 	}
 
-	log.Println("[TEMP]: ", string(body))
+	c.logger.DebugContext(ctx, "received response", "api", api, "status", resp.StatusCode, "body", string(body))
 
-	if resp.StatusCode == http.StatusUnauthorized {
-		log.Printf("[ERROR] Failed to authenticate: %#v\n", string(body))
+	err = checkResponse(resp, method, api, body)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "request failed", "method", method, "api", api, "error", err, "body", string(body))
 
-		return fmt.Errorf("PostAndMarshal failed: %w", ErrOpnsense401)
+		return nil, err
 	}
 
-	err = json.Unmarshal(body, responseData)
+	return body, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, api string, body io.Reader) (*http.Request, error) {
+	url := c.baseURL.String() + "/api/" + api
+	c.logger.DebugContext(ctx, "sending request", "method", method, "url", url)
+
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		log.Printf("[ERROR] Failed to unmarshal POST response: %#v\n", err)
-		log.Printf("[ERROR] Failed to unmarshal POST response: %s\n", string(body))
+		c.logger.ErrorContext(ctx, "failed to create request", "method", method, "url", url, "error", err)
 
-		return err
+		return nil, err
 	}
 
-	return nil
+	request.SetBasicAuth(c.key, c.secret)
+
+	if c.userAgent != "" {
+		request.Header.Set("User-Agent", c.userAgent)
+	}
+
+	return request, nil
 }
 
 // Generic types
@@ -204,9 +231,29 @@ type StatusMessage struct {
 }
 
 type GenericResponse struct {
-	Result      string            `json:"result"`
-	UUID        *uuid.UUID        `json:"uuid,omitempty"`
-	Validations map[string]string `json:"validations,omitempty"`
+	Result      string      `json:"result"`
+	UUID        *uuid.UUID  `json:"uuid,omitempty"`
+	Validations Validations `json:"validations,omitempty"`
+
+	raw []byte
+}
+
+// UnmarshalJSON keeps the raw response around so it can be attached to an
+// APIError if the result turns out to be a failure.
+func (r *GenericResponse) UnmarshalJSON(b []byte) error {
+	type Alias GenericResponse
+
+	var temp Alias
+
+	err := json.Unmarshal(b, &temp)
+	if err != nil {
+		return err
+	}
+
+	*r = GenericResponse(temp)
+	r.raw = append([]byte(nil), b...)
+
+	return nil
 }
 
 type SearchResult struct {
@@ -0,0 +1,155 @@
+package opnsense
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError describes a request OPNsense did not accept, either because of
+// the HTTP status it answered with or because the result in the response
+// body reported a failure. It wraps one of the ErrOpnsense sentinel errors,
+// so errors.Is keeps working, while errors.As gives access to the details.
+type APIError struct {
+	Method      string
+	Endpoint    string
+	StatusCode  int
+	Body        string
+	Validations Validations
+	Err         error
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s %s", e.Method, e.Endpoint)
+
+	if e.StatusCode != http.StatusOK {
+		fmt.Fprintf(&sb, " (status %d)", e.StatusCode)
+	}
+
+	fmt.Fprintf(&sb, ": %s", e.Err)
+
+	if len(e.Validations) > 0 {
+		fmt.Fprintf(&sb, ": %s", e.Validations)
+	}
+
+	return sb.String()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// checkResponse classifies the status code of a response. Anything but a
+// 2xx is returned as an APIError wrapping the matching sentinel error.
+func checkResponse(resp *http.Response, method, api string, body []byte) error {
+	var err error
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err = ErrOpnsense401
+	case http.StatusForbidden:
+		// The API key belongs to a user without the privilege for the page.
+		err = ErrOpnsense403
+	case http.StatusNotFound:
+		// Routes for plugins that are not installed do not exist, everything
+		// else missing is reported by the API with an empty list.
+		err = ErrOpnsensePluginMissing
+	case http.StatusInternalServerError:
+		err = ErrOpnsense500
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		// The web server is restarting, e.g. after a reconfigure.
+		err = ErrOpnsenseUnavailable
+	default:
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			return nil
+		}
+
+		err = ErrOpnsenseUnexpectedStatus
+	}
+
+	return newStatusError(resp, method, api, body, err)
+}
+
+func newStatusError(resp *http.Response, method, api string, body []byte, err error) *APIError {
+	return &APIError{
+		Method:     method,
+		Endpoint:   api,
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		Err:        err,
+	}
+}
+
+// newResultError creates the error for a POST that was answered with 200 but
+// whose result was not the expected one, e.g. "failed" instead of "saved"
+// because one of the fields did not validate.
+func newResultError(api string, response *GenericResponse, err error) *APIError {
+	return &APIError{
+		Method:      http.MethodPost,
+		Endpoint:    api,
+		StatusCode:  http.StatusOK,
+		Body:        string(response.raw),
+		Validations: response.Validations,
+		Err:         err,
+	}
+}
+
+// Validations maps the path of a model field, e.g. alias.name, to the
+// message OPNsense rejected its value with.
+type Validations map[string]string
+
+// OPNsense sends an empty array when there are no validation errors and an
+// array of messages when a field failed more than one constraint.
+func (v *Validations) UnmarshalJSON(b []byte) error {
+	*v = Validations{}
+
+	var messages map[string]json.RawMessage
+
+	err := json.Unmarshal(b, &messages)
+	if err != nil {
+		var empty []interface{}
+
+		return json.Unmarshal(b, &empty)
+	}
+
+	for field, raw := range messages {
+		var message string
+
+		err := json.Unmarshal(raw, &message)
+		if err != nil {
+			var list []string
+
+			err := json.Unmarshal(raw, &list)
+			if err != nil {
+				return err
+			}
+
+			message = strings.Join(list, "\n")
+		}
+
+		(*v)[field] = message
+	}
+
+	return nil
+}
+
+// String lists the validation messages sorted by field.
+func (v Validations) String() string {
+	fields := make([]string, 0, len(v))
+	for field := range v {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%s: %q", field, v[field]))
+	}
+
+	return strings.Join(messages, ", ")
+}
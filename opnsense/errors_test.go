@@ -0,0 +1,136 @@
+package opnsense
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidationsUnmarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Validations
+	}{
+		{
+			name:     "empty array",
+			input:    `[]`,
+			expected: Validations{},
+		},
+		{
+			name:     "messages",
+			input:    `{"alias.name": "An alias with this name already exists."}`,
+			expected: Validations{"alias.name": "An alias with this name already exists."},
+		},
+		{
+			name:     "multiple messages for a field",
+			input:    `{"rule.source_port": ["Invalid port.", "Port required."]}`,
+			expected: Validations{"rule.source_port": "Invalid port.\nPort required."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v Validations
+
+			err := json.Unmarshal([]byte(tt.input), &v)
+			if err != nil {
+				t.Fatalf("Received error from JSON unmarshal, %v", err)
+			}
+
+			if fmt.Sprint(v) != fmt.Sprint(tt.expected) {
+				t.Errorf("Actual does not match expected: %v vs %v", v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAliasAddReturnsAPIError(t *testing.T) {
+	body := `{"result":"failed","validations":{"alias.name":"An alias with this name already exists."}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, err := New(WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	_, err = client.AliasAdd(AliasFormat{Name: "duplicate"})
+	if !errors.Is(err, ErrOpnsenseSave) {
+		t.Errorf("Expected error to wrap ErrOpnsenseSave, got: %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got: %T", err)
+	}
+
+	if apiErr.Endpoint != "firewall/alias/addItem" || apiErr.Body != body {
+		t.Errorf("Unexpected endpoint or body: %s, %s", apiErr.Endpoint, apiErr.Body)
+	}
+
+	if apiErr.Validations["alias.name"] != "An alias with this name already exists." {
+		t.Errorf("Expected validation message, got: %v", apiErr.Validations)
+	}
+
+	expected := `AliasAdd failed: POST firewall/alias/addItem: failed to save: ` +
+		`alias.name: "An alias with this name already exists."`
+	if err.Error() != expected {
+		t.Errorf("Unexpected error message: %s", err)
+	}
+}
+
+func TestResponseClassification(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected error
+	}{
+		{status: http.StatusUnauthorized, expected: ErrOpnsense401},
+		{status: http.StatusForbidden, expected: ErrOpnsense403},
+		{status: http.StatusNotFound, expected: ErrOpnsensePluginMissing},
+		{status: http.StatusInternalServerError, expected: ErrOpnsense500},
+		{status: http.StatusBadGateway, expected: ErrOpnsenseUnavailable},
+		{status: http.StatusServiceUnavailable, expected: ErrOpnsenseUnavailable},
+		{status: http.StatusTeapot, expected: ErrOpnsenseUnexpectedStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client, err := New(WithBaseURL(server.URL))
+			if err != nil {
+				t.Fatalf("Failed to create client: %s", err)
+			}
+
+			_, err = client.FirmwareStatus()
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("GET: expected %v, got: %v", tt.expected, err)
+			}
+
+			_, err = client.WireGuardRestart()
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("POST: expected %v, got: %v", tt.expected, err)
+			}
+
+			_, err = client.Backup()
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Backup: expected %v, got: %v", tt.expected, err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Errorf("Expected APIError with status %d, got: %v", tt.status, err)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package opnsense
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"log"
+	"iter"
 	"path"
 
 	uuid "github.com/satori/go.uuid"
@@ -13,7 +15,7 @@ import (
 // Docs:
 // https://docs.opnsense.org/development/api/plugins/firewall.html
 
-// TODO: Save/Apply function that handles save, check if we locked out, roll back or cancel rollback
+// See FirewallFilterTransaction for applying changes with automatic rollback.
 
 // I think apply will make the changes live, and then revert back to rollbackRevision
 // after 60s if not FirewallFilterCancelRollback is called with rollbackRevision.
@@ -22,6 +24,10 @@ type ApplyStatus struct {
 }
 
 func (c *Client) FirewallFilterApply(rollbackRevision *string) error {
+	return c.FirewallFilterApplyCtx(context.Background(), rollbackRevision)
+}
+
+func (c *Client) FirewallFilterApplyCtx(ctx context.Context, rollbackRevision *string) error {
 	api := "firewall/filter/apply"
 	if rollbackRevision != nil {
 		api = path.Join("firewall/filter/apply", *rollbackRevision)
@@ -29,13 +35,13 @@ func (c *Client) FirewallFilterApply(rollbackRevision *string) error {
 
 	var response ApplyStatus
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return err
 	}
 
 	if response.Status != "OK\n\n" {
-		log.Printf("[TRACE] FirewallFilterApply response: %#v", response)
+		c.logger.DebugContext(ctx, "FirewallFilterApply response", "response", response)
 
 		return fmt.Errorf("FirewallFilterApply failed: %w", ErrOpnsenseStatusNotOk)
 	}
@@ -44,11 +50,15 @@ func (c *Client) FirewallFilterApply(rollbackRevision *string) error {
 }
 
 func (c *Client) FirewallFilterCancelRollback(rollbackRevision string) (*GenericResponse, error) {
+	return c.FirewallFilterCancelRollbackCtx(context.Background(), rollbackRevision)
+}
+
+func (c *Client) FirewallFilterCancelRollbackCtx(ctx context.Context, rollbackRevision string) (*GenericResponse, error) {
 	api := path.Join("firewall/filter/cancelRollback", rollbackRevision)
 
 	var response GenericResponse
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -57,11 +67,15 @@ func (c *Client) FirewallFilterCancelRollback(rollbackRevision string) (*Generic
 }
 
 func (c *Client) FirewallFilterRevert(revision string) (*GenericResponse, error) {
+	return c.FirewallFilterRevertCtx(context.Background(), revision)
+}
+
+func (c *Client) FirewallFilterRevertCtx(ctx context.Context, revision string) (*GenericResponse, error) {
 	api := path.Join("firewall/filter/revert", revision)
 
 	var response GenericResponse
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -77,11 +91,15 @@ type Savepoint struct {
 
 // Fetch the previous revision/create a revision _before_ we do stuff?
 func (c *Client) FirewallFilterSavepoint() (*Savepoint, error) {
+	return c.FirewallFilterSavepointCtx(context.Background())
+}
+
+func (c *Client) FirewallFilterSavepointCtx(ctx context.Context) (*Savepoint, error) {
 	api := "firewall/filter/savepoint"
 
 	var response Savepoint
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -89,149 +107,396 @@ func (c *Client) FirewallFilterSavepoint() (*Savepoint, error) {
 	return &response, nil
 }
 
+// Action is what a filter rule does with matching packets.
+type Action string
+
+const (
+	ActionPass   Action = "pass"
+	ActionBlock  Action = "block"
+	ActionReject Action = "reject"
+)
+
+func (a Action) Valid() bool {
+	switch a {
+	case ActionPass, ActionBlock, ActionReject:
+		return true
+	}
+
+	return false
+}
+
+func (a *Action) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, map[string]string{
+		"pass":   string(ActionPass),
+		"block":  string(ActionBlock),
+		"reject": string(ActionReject),
+	})
+	if err != nil {
+		return err
+	}
+
+	*a = Action(option)
+
+	return nil
+}
+
+// Direction is the direction of the traffic a filter rule matches.
+type Direction string
+
+const (
+	DirectionIn  Direction = "in"
+	DirectionOut Direction = "out"
+	DirectionAny Direction = "any"
+)
+
+func (d Direction) Valid() bool {
+	switch d {
+	case DirectionIn, DirectionOut, DirectionAny:
+		return true
+	}
+
+	return false
+}
+
+func (d *Direction) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, map[string]string{
+		"in":  string(DirectionIn),
+		"out": string(DirectionOut),
+		"any": string(DirectionAny),
+	})
+	if err != nil {
+		return err
+	}
+
+	*d = Direction(option)
+
+	return nil
+}
+
+// IPProtocol is the address family a filter rule matches.
+type IPProtocol string
+
+const (
+	IPProtocolInet   IPProtocol = "inet"
+	IPProtocolInet6  IPProtocol = "inet6"
+	IPProtocolInet46 IPProtocol = "inet46"
+)
+
+func (p IPProtocol) Valid() bool {
+	switch p {
+	case IPProtocolInet, IPProtocolInet6, IPProtocolInet46:
+		return true
+	}
+
+	return false
+}
+
+func (p *IPProtocol) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, map[string]string{
+		"ipv4":      string(IPProtocolInet),
+		"ipv6":      string(IPProtocolInet6),
+		"ipv4+ipv6": string(IPProtocolInet46),
+	})
+	if err != nil {
+		return err
+	}
+
+	*p = IPProtocol(option)
+
+	return nil
+}
+
+// Gateway is the name of the gateway or gateway group a filter rule routes
+// its traffic through, empty for the default route.
+type Gateway string
+
+func (g *Gateway) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, nil)
+	if err != nil {
+		return err
+	}
+
+	*g = Gateway(option)
+
+	return nil
+}
+
 type FilterRule struct {
 	UUID            *uuid.UUID     `json:"uuid,omitempty"`
-	Enabled         Bool           `json:"enabled,omitempty"`
+	Enabled         Bool           `json:"enabled"`
 	Sequence        Integer        `json:"sequence,omitempty"`
-	Action          SelectedMap    `json:"action,omitempty"`
-	Quick           Bool           `json:"quick,omitempty"`
+	Action          Action         `json:"action,omitempty"`
+	Quick           Bool           `json:"quick"`
 	Interface       Interface      `json:"interface,omitempty"` // InterfaceField
-	Direction       SelectedMap    `json:"direction,omitempty"`
-	IPProtocol      SelectedMap    `json:"ipprotocol,omitempty"`
+	Direction       Direction      `json:"direction,omitempty"`
+	IPProtocol      IPProtocol     `json:"ipprotocol,omitempty"`
 	Protocol        Protocol       `json:"protocol,omitempty"`   // ProtocolField
 	SourceNet       NetworkOrAlias `json:"source_net,omitempty"` // NetworkAliasField
-	SourceNot       Bool           `json:"source_not,omitempty"`
+	SourceNot       Bool           `json:"source_not"`
 	SourcePort      *PortRange     `json:"source_port,omitempty"`     // Custom port range type PortField
 	DestinationNet  NetworkOrAlias `json:"destination_net,omitempty"` // NetworkAliasField
-	DestinationNot  Bool           `json:"destination_not,omitempty"`
+	DestinationNot  Bool           `json:"destination_not"`
 	DestinationPort *PortRange     `json:"destination_port,omitempty"`
-	Gateway         string         `json:"gateway,omitempty"` // JsonKeyValueStoreField
-	Log             Bool           `json:"log,omitempty"`
-	Description     string         `json:"description,omitempty"`
+	Gateway         Gateway        `json:"gateway,omitempty"` // JsonKeyValueStoreField
+	Log             Bool           `json:"log"`
+	// Categories is nil when the categories are not known, which leaves
+	// them unchanged on set. An empty Categories removes every category.
+	Categories  *Categories `json:"categories,omitempty"`
+	Description string      `json:"description,omitempty"`
 }
 
-func (c *Client) FirewallFilterRuleGet(uuid uuid.UUID) (*FilterRule, error) {
-	api := path.Join("firewall/filter/getRule", uuid.String())
+// Validate checks the options of the rule before it is sent to OPNsense.
+// Empty options are left to the defaults of OPNsense.
+func (r *FilterRule) Validate() error {
+	options := []struct {
+		field string
+		value string
+		valid bool
+	}{
+		{"action", string(r.Action), r.Action.Valid()},
+		{"direction", string(r.Direction), r.Direction.Valid()},
+		{"ipprotocol", string(r.IPProtocol), r.IPProtocol.Valid()},
+	}
 
-	type Response struct {
-		Rule FilterRule `json:"rule"`
+	for _, option := range options {
+		if option.value != "" && !option.valid {
+			return fmt.Errorf("%w: %s %q", ErrOpnsenseInvalidOption, option.field, option.value)
+		}
 	}
 
-	var response Response
+	return nil
+}
+
+// filterRule drops the JSON methods of FilterRule.
+type filterRule FilterRule
 
-	err := c.PostAndMarshal(api, nil, &response)
+// MarshalJSON refuses to encode a rule that does not pass Validate.
+func (r FilterRule) MarshalJSON() ([]byte, error) {
+	err := r.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	return &response.Rule, nil
+	return json.Marshal(filterRule(r))
+}
+
+func (r *FilterRule) setUUID(id uuid.UUID) {
+	r.UUID = &id
+}
+
+// filterRuleResourceConfig is shared by the resources reading and writing
+// filter rules.
+var filterRuleResourceConfig = ResourceConfig{
+	Module:      "firewall/filter",
+	Key:         "rule",
+	Suffix:      "Rule",
+	Reconfigure: "firewall/filter/apply",
+}
+
+func (c *Client) filterRuleResource() *Resource[FilterRule, FilterRule] {
+	return NewResource[FilterRule, FilterRule](c, filterRuleResourceConfig)
+}
+
+func (c *Client) FirewallFilterRuleGet(uuid uuid.UUID) (*FilterRule, error) {
+	return c.FirewallFilterRuleGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallFilterRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*FilterRule, error) {
+	return c.filterRuleResource().Get(ctx, uuid)
 }
 
 func (c *Client) FirewallFilterRuleSet(rule *FilterRule) error {
-	api := path.Join("firewall/filter/setRule", rule.UUID.String())
+	return c.FirewallFilterRuleSetCtx(context.Background(), rule)
+}
 
-	request := map[string]interface{}{
-		"rule": rule,
+func (c *Client) FirewallFilterRuleSetCtx(ctx context.Context, rule *FilterRule) error {
+	err := rule.Validate()
+	if err != nil {
+		return fmt.Errorf("FirewallFilterRuleSet failed: %w", err)
 	}
 
-	var response GenericResponse
+	if rule.UUID == nil {
+		return fmt.Errorf("FirewallFilterRuleSet failed: %w", ErrOpnsenseMissingUUID)
+	}
 
-	err := c.PostAndMarshal(api, request, &response)
+	err = c.checkInterfaces(ctx, rule.Interface)
 	if err != nil {
-		return err
+		return fmt.Errorf("FirewallFilterRuleSet failed: %w", err)
 	}
 
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] FirewallFilterRuleSet response: %#v", response)
-
-		return fmt.Errorf("FirewallFilterRuleSet failed: %w", ErrOpnsenseSave)
+	_, err = c.filterRuleResource().Set(ctx, *rule.UUID, *rule)
+	if err != nil {
+		return fmt.Errorf("FirewallFilterRuleSet failed: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) FirewallFilterRuleAdd(rule *FilterRule) error {
-	api := "firewall/filter/addRule"
-
-	var response GenericResponse
+func (c *Client) FirewallFilterRuleAdd(rule *FilterRule) (*uuid.UUID, error) {
+	return c.FirewallFilterRuleAddCtx(context.Background(), rule)
+}
 
-	request := map[string]interface{}{
-		"rule": rule,
+// FirewallFilterRuleAddCtx adds rule and sets rule.UUID to the UUID OPNsense
+// assigned to it.
+func (c *Client) FirewallFilterRuleAddCtx(ctx context.Context, rule *FilterRule) (*uuid.UUID, error) {
+	err := rule.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("FirewallFilterRuleAdd failed: %w", err)
 	}
 
-	err := c.PostAndMarshal(api, request, &response)
+	err = c.checkInterfaces(ctx, rule.Interface)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("FirewallFilterRuleAdd failed: %w", err)
 	}
 
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] FirewallFilterRuleAdd response: %#v", response)
-
-		return fmt.Errorf("FirewallFilterRuleAdd failed: %w", ErrOpnsenseSave)
+	response, err := c.filterRuleResource().Add(ctx, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallFilterRuleAdd failed: %w", err)
 	}
 
-	return nil
+	rule.UUID = response.UUID
+
+	return response.UUID, nil
 }
 
 func (c *Client) FirewallFilterRuleDelete(uuid uuid.UUID) error {
-	api := path.Join("firewall/filter/delRule", uuid.String())
-
-	var response GenericResponse
+	return c.FirewallFilterRuleDeleteCtx(context.Background(), uuid)
+}
 
-	err := c.PostAndMarshal(api, nil, &response)
+func (c *Client) FirewallFilterRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.filterRuleResource().Delete(ctx, uuid)
 	if err != nil {
-		return err
-	}
-
-	if response.Result != StatusDeleted {
-		log.Printf("[TRACE] FirewallFilterRuleDelete response: %#v", response)
-
-		return fmt.Errorf("FirewallFilterRuleDelete failed: %w", ErrOpnsenseDelete)
+		return fmt.Errorf("FirewallFilterRuleDelete failed: %w", err)
 	}
 
 	return nil
 }
 
 func (c *Client) FirewallFilterRuleSearch() ([]*FilterRule, error) {
-	api := "firewall/filter/searchRule"
+	return c.FirewallFilterRuleSearchCtx(context.Background())
+}
 
-	type SearchResult struct {
-		Rows []*FilterRule `json:"rows"`
-		SearchResultPartial
-	}
+func (c *Client) FirewallFilterRuleSearchCtx(ctx context.Context) ([]*FilterRule, error) {
+	return collect(c.FirewallFilterRuleSearchAll(ctx, SearchOptions{}))
+}
 
-	var response SearchResult
+// filterRuleRow is a filter rule as search returns it, with the names of its
+// categories rather than their UUIDs.
+type filterRuleRow struct {
+	*FilterRule
 
-	err := c.GetAndUnmarshal(api, &response)
-	if err != nil {
-		return nil, err
+	Categories *string `json:"categories"`
+}
+
+// FirewallFilterRuleSearchAll iterates over the filter rules matching opts,
+// fetching pages as they are needed. The category names search returns are
+// resolved to UUIDs through the category list, Categories is left nil if
+// one of them cannot be.
+func (c *Client) FirewallFilterRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*FilterRule, error] {
+	return func(yield func(*FilterRule, error) bool) {
+		var categories map[string]uuid.UUID
+
+		for row, err := range SearchAll[filterRuleRow](ctx, c, c.filterRuleResource().searchEndpoint(), opts) {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			if row.FilterRule == nil {
+				row.FilterRule = &FilterRule{}
+			}
+
+			if row.Categories != nil && *row.Categories != "" && categories == nil {
+				categories, err = c.firewallCategoryUUIDs(ctx)
+				if err != nil {
+					yield(nil, fmt.Errorf("FirewallFilterRuleSearch failed to list categories: %w", err))
+
+					return
+				}
+			}
+
+			row.FilterRule.Categories = resolveCategories(row.Categories, categories)
+
+			if !yield(row.FilterRule, nil) {
+				return
+			}
+		}
 	}
+}
 
-	return response.Rows, nil
+func (c *Client) FirewallFilterRuleFindByDescription(description string) ([]*FilterRule, error) {
+	return c.FirewallFilterRuleFindByDescriptionCtx(context.Background(), description)
 }
 
-func (c *Client) FirewallFilterRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
-	api := path.Join("firewall/filter/toggleRule", uuid.String(), enabled.URLArgument())
+// FirewallFilterRuleFindByDescriptionCtx returns the rules whose description
+// is exactly description.
+func (c *Client) FirewallFilterRuleFindByDescriptionCtx(ctx context.Context, description string) ([]*FilterRule, error) {
+	rules := []*FilterRule{}
 
-	var response GenericResponse
+	for rule, err := range c.FirewallFilterRuleSearchAll(ctx, SearchOptions{SearchPhrase: description}) {
+		if err != nil {
+			return nil, err
+		}
 
-	err := c.PostAndMarshal(api, nil, &response)
-	if err != nil {
-		return nil, err
+		if rule.Description == description {
+			rules = append(rules, rule)
+		}
 	}
 
-	return &response, nil
+	return rules, nil
 }
 
-func (c *Client) FirewallSourceNatRuleSearch() (*GenericResponse, error) {
-	api := "firewall/source_nat/searchRule"
+func (c *Client) FirewallFilterRuleFindByCategory(category uuid.UUID) ([]*FilterRule, error) {
+	return c.FirewallFilterRuleFindByCategoryCtx(context.Background(), category)
+}
 
-	var response GenericResponse
+// FirewallFilterRuleFindByCategoryCtx returns the rules in the category with
+// the given UUID.
+func (c *Client) FirewallFilterRuleFindByCategoryCtx(ctx context.Context, category uuid.UUID) ([]*FilterRule, error) {
+	return collect(c.FirewallFilterRuleSearchAll(ctx, SearchOptions{
+		Filters: map[string]interface{}{
+			"category": []string{category.String()},
+		},
+	}))
+}
+
+func (c *Client) FirewallFilterRuleDeleteByCategory(category uuid.UUID) ([]uuid.UUID, error) {
+	return c.FirewallFilterRuleDeleteByCategoryCtx(context.Background(), category)
+}
 
-	err := c.GetAndUnmarshal(api, &response)
+// FirewallFilterRuleDeleteByCategoryCtx deletes every rule in the category
+// with the given UUID and returns the UUIDs of the deleted rules. It stops at
+// the first rule that fails to delete. Like other changes, the deletions only
+// take effect once applied.
+func (c *Client) FirewallFilterRuleDeleteByCategoryCtx(ctx context.Context, category uuid.UUID) ([]uuid.UUID, error) {
+	rules, err := c.FirewallFilterRuleFindByCategoryCtx(ctx, category)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("FirewallFilterRuleDeleteByCategory failed: %w", err)
 	}
 
-	return &response, nil
+	deleted := []uuid.UUID{}
+
+	for _, rule := range rules {
+		if rule.UUID == nil {
+			continue
+		}
+
+		err := c.FirewallFilterRuleDeleteCtx(ctx, *rule.UUID)
+		if err != nil {
+			return deleted, fmt.Errorf("FirewallFilterRuleDeleteByCategory failed: %w", err)
+		}
+
+		deleted = append(deleted, *rule.UUID)
+	}
+
+	return deleted, nil
+}
+
+func (c *Client) FirewallFilterRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.FirewallFilterRuleToggleCtx(context.Background(), uuid, enabled)
+}
+
+func (c *Client) FirewallFilterRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.filterRuleResource().Toggle(ctx, uuid, enabled)
 }
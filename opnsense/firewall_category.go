@@ -0,0 +1,173 @@
+package opnsense
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Category groups firewall rules and aliases, e.g. to tell the rules managed
+// by a tool apart from the ones made by hand.
+type Category struct {
+	UUID *uuid.UUID `json:"uuid,omitempty"`
+	Name string     `json:"name"`
+	// Auto marks categories OPNsense created on its own for items referring
+	// to a name that did not exist. They are removed once unused.
+	Auto Bool `json:"auto"`
+	// Color is a hex colour without the #, e.g. ff0000.
+	Color string `json:"color"`
+}
+
+func (c *Category) setUUID(id uuid.UUID) {
+	c.UUID = &id
+}
+
+func (c *Client) categoryResource() *Resource[Category, Category] {
+	return NewResource[Category, Category](c, ResourceConfig{
+		Module: "firewall/category",
+		Key:    "category",
+		Suffix: "Item",
+	})
+}
+
+func (c *Client) FirewallCategoryGet(uuid uuid.UUID) (*Category, error) {
+	return c.FirewallCategoryGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallCategoryGetCtx(ctx context.Context, uuid uuid.UUID) (*Category, error) {
+	return c.categoryResource().Get(ctx, uuid)
+}
+
+func (c *Client) FirewallCategorySearch() ([]*Category, error) {
+	return c.FirewallCategorySearchCtx(context.Background())
+}
+
+func (c *Client) FirewallCategorySearchCtx(ctx context.Context) ([]*Category, error) {
+	return collect(c.FirewallCategorySearchAll(ctx, SearchOptions{}))
+}
+
+// FirewallCategorySearchAll iterates over the categories matching opts,
+// fetching pages as they are needed.
+func (c *Client) FirewallCategorySearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*Category, error] {
+	return SearchAll[*Category](ctx, c, c.categoryResource().searchEndpoint(), opts)
+}
+
+func (c *Client) FirewallCategoryFindByName(name string) (*Category, error) {
+	return c.FirewallCategoryFindByNameCtx(context.Background(), name)
+}
+
+// FirewallCategoryFindByNameCtx returns the category called name, names are
+// unique. ErrOpnsenseNotFound is returned if there is none.
+func (c *Client) FirewallCategoryFindByNameCtx(ctx context.Context, name string) (*Category, error) {
+	for category, err := range c.FirewallCategorySearchAll(ctx, SearchOptions{SearchPhrase: name}) {
+		if err != nil {
+			return nil, err
+		}
+
+		if category.Name == name {
+			return category, nil
+		}
+	}
+
+	return nil, fmt.Errorf("category %q: %w", name, ErrOpnsenseNotFound)
+}
+
+// firewallCategoryUUIDs maps the name of every category to its UUID.
+func (c *Client) firewallCategoryUUIDs(ctx context.Context) (map[string]uuid.UUID, error) {
+	uuids := map[string]uuid.UUID{}
+
+	for category, err := range c.FirewallCategorySearchAll(ctx, SearchOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+
+		if category.UUID != nil {
+			uuids[category.Name] = *category.UUID
+		}
+	}
+
+	return uuids, nil
+}
+
+// resolveCategories turns the comma separated categories of a search row,
+// names or UUIDs, into Categories. It returns nil if field is missing or a
+// name is not in uuids.
+func resolveCategories(field *string, uuids map[string]uuid.UUID) *Categories {
+	if field == nil {
+		return nil
+	}
+
+	categories := Categories{}
+
+	if *field == "" {
+		return &categories
+	}
+
+	for _, name := range strings.Split(*field, ",") {
+		name = strings.TrimSpace(name)
+
+		id, err := uuid.FromString(name)
+		if err != nil {
+			var ok bool
+
+			id, ok = uuids[name]
+			if !ok {
+				return nil
+			}
+		}
+
+		categories = append(categories, id)
+	}
+
+	return &categories
+}
+
+func (c *Client) FirewallCategoryAdd(category *Category) (*uuid.UUID, error) {
+	return c.FirewallCategoryAddCtx(context.Background(), category)
+}
+
+// FirewallCategoryAddCtx adds category and sets category.UUID to the UUID
+// OPNsense assigned to it.
+func (c *Client) FirewallCategoryAddCtx(ctx context.Context, category *Category) (*uuid.UUID, error) {
+	response, err := c.categoryResource().Add(ctx, *category)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallCategoryAdd failed: %w", err)
+	}
+
+	category.UUID = response.UUID
+
+	return response.UUID, nil
+}
+
+func (c *Client) FirewallCategorySet(category *Category) error {
+	return c.FirewallCategorySetCtx(context.Background(), category)
+}
+
+func (c *Client) FirewallCategorySetCtx(ctx context.Context, category *Category) error {
+	if category.UUID == nil {
+		return fmt.Errorf("FirewallCategorySet failed: %w", ErrOpnsenseMissingUUID)
+	}
+
+	_, err := c.categoryResource().Set(ctx, *category.UUID, *category)
+	if err != nil {
+		return fmt.Errorf("FirewallCategorySet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallCategoryDelete(uuid uuid.UUID) error {
+	return c.FirewallCategoryDeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallCategoryDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.categoryResource().Delete(ctx, uuid)
+	if err != nil {
+		return fmt.Errorf("FirewallCategoryDelete failed: %w", err)
+	}
+
+	return nil
+}
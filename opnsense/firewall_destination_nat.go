@@ -0,0 +1,379 @@
+package opnsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// FilterRuleAssociation controls the filter rule OPNsense creates to let the
+// traffic of a port forward through.
+type FilterRuleAssociation string
+
+const (
+	// FilterRuleNone creates no filter rule, traffic has to be passed by a
+	// rule of its own, e.g. one made with DestinationNatRule.FilterRule.
+	FilterRuleNone FilterRuleAssociation = ""
+	// FilterRuleAssociated creates a filter rule that is updated and deleted
+	// together with the NAT rule.
+	FilterRuleAssociated FilterRuleAssociation = "add-associated"
+	// FilterRuleUnassociated creates a filter rule once, which is left alone
+	// afterwards.
+	FilterRuleUnassociated FilterRuleAssociation = "add-unassociated"
+	// FilterRulePass passes the traffic without a filter rule.
+	FilterRulePass FilterRuleAssociation = "pass"
+)
+
+func (a FilterRuleAssociation) Valid() bool {
+	switch a {
+	case FilterRuleNone, FilterRuleAssociated, FilterRuleUnassociated, FilterRulePass:
+		return true
+	}
+
+	return false
+}
+
+func (a *FilterRuleAssociation) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, map[string]string{
+		"none":                         string(FilterRuleNone),
+		"add associated filter rule":   string(FilterRuleAssociated),
+		"add unassociated filter rule": string(FilterRuleUnassociated),
+		"pass":                         string(FilterRulePass),
+	})
+	if err != nil {
+		return err
+	}
+
+	*a = FilterRuleAssociation(option)
+
+	return nil
+}
+
+func (a FilterRuleAssociation) MarshalJSON() ([]byte, error) {
+	return optionToJSON("filter-rule-association", string(a), a.Valid())
+}
+
+// DestinationNatRule is a port forward, redirecting traffic arriving on
+// Interface to Target. Unlike the other NAT rules it has Disabled instead of
+// Enabled, and it keeps the field names of the legacy port forwards, which
+// nest the source and destination in containers of their own.
+type DestinationNatRule struct {
+	UUID            *uuid.UUID     `json:"uuid,omitempty"`
+	Disabled        Bool           `json:"disabled"`
+	NoRdr           Bool           `json:"nordr"`
+	Sequence        Integer        `json:"sequence,omitempty"`
+	Interface       Interface      `json:"interface,omitempty"`
+	IPProtocol      IPProtocol     `json:"ipprotocol,omitempty"`
+	Protocol        Protocol       `json:"protocol,omitempty"`
+	SourceNet       NetworkOrAlias `json:"-"` // source.network
+	SourceNot       Bool           `json:"-"` // source.not
+	SourcePort      *PortRange     `json:"-"` // source.port
+	DestinationNet  NetworkOrAlias `json:"-"` // destination.network
+	DestinationNot  Bool           `json:"-"` // destination.not
+	DestinationPort *PortRange     `json:"-"` // destination.port
+	// Target is the internal address traffic is forwarded to.
+	Target    NetworkOrAlias `json:"target,omitempty"`
+	LocalPort *PortRange     `json:"local-port,omitempty"`
+	// FilterRuleAssociation is only read by OPNsense when the rule is added.
+	FilterRuleAssociation FilterRuleAssociation `json:"filter-rule-association,omitempty"`
+	AssociatedRuleID      string                `json:"associated-rule-id,omitempty"`
+	Log                   Bool                  `json:"log"`
+	Description           string                `json:"descr,omitempty"`
+}
+
+// natEndpoint is the source or destination container of a port forward.
+type natEndpoint struct {
+	Network NetworkOrAlias `json:"network,omitempty"`
+	Not     Bool           `json:"not"`
+	Port    *PortRange     `json:"port,omitempty"`
+}
+
+// destinationNatRuleJSON is a DestinationNatRule the way OPNsense nests it.
+type destinationNatRuleJSON struct {
+	destinationNatRule
+	Source      natEndpoint `json:"source"`
+	Destination natEndpoint `json:"destination"`
+}
+
+// destinationNatRule drops the JSON methods of DestinationNatRule, so it can
+// be embedded in destinationNatRuleJSON.
+type destinationNatRule DestinationNatRule
+
+// MarshalJSON refuses to encode a rule that does not pass Validate.
+func (r DestinationNatRule) MarshalJSON() ([]byte, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(destinationNatRuleJSON{
+		destinationNatRule: destinationNatRule(r),
+		Source:             natEndpoint{Network: r.SourceNet, Not: r.SourceNot, Port: r.SourcePort},
+		Destination:        natEndpoint{Network: r.DestinationNet, Not: r.DestinationNot, Port: r.DestinationPort},
+	})
+}
+
+// UnmarshalJSON reads the containers both nested, as get returns them, and
+// flattened to e.g. source.network, as search returns them.
+func (r *DestinationNatRule) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+
+	err := json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+
+	containers := map[string]map[string]json.RawMessage{}
+
+	for key, value := range fields {
+		container, field, ok := strings.Cut(key, ".")
+		if !ok {
+			continue
+		}
+
+		if containers[container] == nil {
+			containers[container] = map[string]json.RawMessage{}
+		}
+
+		containers[container][field] = value
+		delete(fields, key)
+	}
+
+	for container, values := range containers {
+		fields[container], err = json.Marshal(values)
+		if err != nil {
+			return err
+		}
+	}
+
+	nested, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	var rule destinationNatRuleJSON
+
+	err = json.Unmarshal(nested, &rule)
+	if err != nil {
+		return err
+	}
+
+	*r = DestinationNatRule(rule.destinationNatRule)
+	r.SourceNet, r.SourceNot, r.SourcePort = rule.Source.Network, rule.Source.Not, rule.Source.Port
+	r.DestinationNet, r.DestinationNot, r.DestinationPort =
+		rule.Destination.Network, rule.Destination.Not, rule.Destination.Port
+
+	return nil
+}
+
+// Validate checks the options of the rule before it is sent to OPNsense.
+func (r *DestinationNatRule) Validate() error {
+	if r.IPProtocol != "" && !r.IPProtocol.Valid() {
+		return fmt.Errorf("%w: ipprotocol %q", ErrOpnsenseInvalidOption, r.IPProtocol)
+	}
+
+	if !r.FilterRuleAssociation.Valid() {
+		return fmt.Errorf("%w: filter-rule-association %q", ErrOpnsenseInvalidOption, r.FilterRuleAssociation)
+	}
+
+	return nil
+}
+
+// FilterRule returns a filter rule passing the traffic r forwards, for port
+// forwards added with FilterRuleNone. It matches the translated packets, so
+// the destination is the target of r.
+func (r *DestinationNatRule) FilterRule() *FilterRule {
+	description := "NAT"
+	if r.Description != "" {
+		description += " " + r.Description
+	}
+
+	destinationPort := r.LocalPort
+	if destinationPort == nil {
+		destinationPort = r.DestinationPort
+	}
+
+	return &FilterRule{
+		Enabled:         !r.Disabled,
+		Action:          ActionPass,
+		Quick:           true,
+		Interface:       r.Interface,
+		Direction:       DirectionIn,
+		IPProtocol:      r.IPProtocol,
+		Protocol:        r.Protocol,
+		SourceNet:       r.SourceNet,
+		SourceNot:       r.SourceNot,
+		SourcePort:      r.SourcePort,
+		DestinationNet:  r.Target,
+		DestinationPort: destinationPort,
+		Log:             r.Log,
+		Description:     description,
+	}
+}
+
+func (r *DestinationNatRule) setUUID(id uuid.UUID) {
+	r.UUID = &id
+}
+
+func (c *Client) destinationNatRuleResource() *Resource[DestinationNatRule, DestinationNatRule] {
+	return NewResource[DestinationNatRule, DestinationNatRule](c, ResourceConfig{
+		Module:      "firewall/d_nat",
+		Key:         "rule",
+		Suffix:      "Rule",
+		Reconfigure: "firewall/filter/apply",
+	})
+}
+
+func (c *Client) FirewallDestinationNatRuleGet(uuid uuid.UUID) (*DestinationNatRule, error) {
+	return c.FirewallDestinationNatRuleGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallDestinationNatRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*DestinationNatRule, error) {
+	return c.destinationNatRuleResource().Get(ctx, uuid)
+}
+
+func (c *Client) FirewallDestinationNatRuleSearch() ([]*DestinationNatRule, error) {
+	return c.FirewallDestinationNatRuleSearchCtx(context.Background())
+}
+
+func (c *Client) FirewallDestinationNatRuleSearchCtx(ctx context.Context) ([]*DestinationNatRule, error) {
+	return collect(c.FirewallDestinationNatRuleSearchAll(ctx, SearchOptions{}))
+}
+
+func (c *Client) FirewallDestinationNatRuleSearchAll(
+	ctx context.Context,
+	opts SearchOptions,
+) iter.Seq2[*DestinationNatRule, error] {
+	return SearchAll[*DestinationNatRule](ctx, c, c.destinationNatRuleResource().searchEndpoint(), opts)
+}
+
+func (c *Client) FirewallDestinationNatRuleAdd(rule *DestinationNatRule) (*uuid.UUID, error) {
+	return c.FirewallDestinationNatRuleAddCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallDestinationNatRuleAddCtx(ctx context.Context, rule *DestinationNatRule) (*uuid.UUID, error) {
+	err := rule.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("FirewallDestinationNatRuleAdd failed: %w", err)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallDestinationNatRuleAdd failed: %w", err)
+	}
+
+	response, err := c.destinationNatRuleResource().Add(ctx, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallDestinationNatRuleAdd failed: %w", err)
+	}
+
+	rule.UUID = response.UUID
+
+	return response.UUID, nil
+}
+
+// FirewallDestinationNatRuleAddWithFilterRule adds rule together with the
+// filter rule passing its traffic, returned by rule.FilterRule. Unlike
+// FilterRuleAssociated, the filter rule is managed through the filter API and
+// can be found, changed and deleted like any other.
+//
+// If the filter rule cannot be added, the NAT rule is deleted again. Should
+// that fail too, both errors are returned and rule.UUID is left set.
+func (c *Client) FirewallDestinationNatRuleAddWithFilterRule(rule *DestinationNatRule) (*FilterRule, error) {
+	return c.FirewallDestinationNatRuleAddWithFilterRuleCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallDestinationNatRuleAddWithFilterRuleCtx(
+	ctx context.Context,
+	rule *DestinationNatRule,
+) (*FilterRule, error) {
+	if rule.FilterRuleAssociation != FilterRuleNone {
+		return nil, fmt.Errorf(
+			"FirewallDestinationNatRuleAddWithFilterRule failed: %w: filter-rule-association %q",
+			ErrOpnsenseInvalidOption, rule.FilterRuleAssociation,
+		)
+	}
+
+	_, err := c.FirewallDestinationNatRuleAddCtx(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	filterRule := rule.FilterRule()
+
+	_, err = c.FirewallFilterRuleAddCtx(ctx, filterRule)
+	if err != nil {
+		err = fmt.Errorf("FirewallDestinationNatRuleAddWithFilterRule failed: %w", err)
+
+		// Do not leave a port forward behind whose traffic is not passed.
+		deleteErr := c.FirewallDestinationNatRuleDeleteCtx(context.WithoutCancel(ctx), *rule.UUID)
+		if deleteErr != nil {
+			return nil, errors.Join(err, deleteErr)
+		}
+
+		rule.UUID = nil
+
+		return nil, err
+	}
+
+	return filterRule, nil
+}
+
+func (c *Client) FirewallDestinationNatRuleSet(rule *DestinationNatRule) error {
+	return c.FirewallDestinationNatRuleSetCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallDestinationNatRuleSetCtx(ctx context.Context, rule *DestinationNatRule) error {
+	err := rule.Validate()
+	if err != nil {
+		return fmt.Errorf("FirewallDestinationNatRuleSet failed: %w", err)
+	}
+
+	if rule.UUID == nil {
+		return fmt.Errorf("FirewallDestinationNatRuleSet failed: %w", ErrOpnsenseMissingUUID)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return fmt.Errorf("FirewallDestinationNatRuleSet failed: %w", err)
+	}
+
+	_, err = c.destinationNatRuleResource().Set(ctx, *rule.UUID, *rule)
+	if err != nil {
+		return fmt.Errorf("FirewallDestinationNatRuleSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallDestinationNatRuleDelete(uuid uuid.UUID) error {
+	return c.FirewallDestinationNatRuleDeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallDestinationNatRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.destinationNatRuleResource().Delete(ctx, uuid)
+	if err != nil {
+		return fmt.Errorf("FirewallDestinationNatRuleDelete failed: %w", err)
+	}
+
+	return nil
+}
+
+// FirewallDestinationNatRuleToggle enables or disables the rule, which
+// OPNsense stores inverted in Disabled.
+func (c *Client) FirewallDestinationNatRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.FirewallDestinationNatRuleToggleCtx(context.Background(), uuid, enabled)
+}
+
+func (c *Client) FirewallDestinationNatRuleToggleCtx(
+	ctx context.Context,
+	uuid uuid.UUID,
+	enabled Bool,
+) (*GenericResponse, error) {
+	return c.destinationNatRuleResource().Toggle(ctx, uuid, enabled)
+}
@@ -0,0 +1,116 @@
+package opnsense
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FilterRuleChange is a field that differs between two filter rules, with
+// the values in their normalised form.
+type FilterRuleChange struct {
+	// Field is the name OPNsense uses for the field, e.g. destination_port.
+	Field string
+	From  string
+	To    string
+}
+
+func (c FilterRuleChange) String() string {
+	return fmt.Sprintf("%s: %q => %q", c.Field, c.From, c.To)
+}
+
+// Diff returns the fields that differ from r to other, ignoring the UUID.
+// Values are normalised before they are compared, so equivalent forms are
+// not reported:
+//
+//   - a missing network, protocol or port is any,
+//   - a port range from and to the same port is that port,
+//   - interfaces and categories are compared as sets,
+//   - protocols are compared without case.
+func (r *FilterRule) Diff(other *FilterRule) []FilterRuleChange {
+	from := r.normalised()
+	to := other.normalised()
+	changes := []FilterRuleChange{}
+
+	for i := range from {
+		if from[i].value != to[i].value {
+			changes = append(changes, FilterRuleChange{
+				Field: from[i].field,
+				From:  from[i].value,
+				To:    to[i].value,
+			})
+		}
+	}
+
+	return changes
+}
+
+type normalisedField struct {
+	field string
+	value string
+}
+
+func (r *FilterRule) normalised() []normalisedField {
+	categories := []string{}
+	if r.Categories != nil {
+		for _, category := range *r.Categories {
+			categories = append(categories, category.String())
+		}
+	}
+
+	return []normalisedField{
+		{"enabled", r.Enabled.URLArgument()},
+		{"sequence", strconv.Itoa(int(r.Sequence))},
+		{"action", string(r.Action)},
+		{"quick", r.Quick.URLArgument()},
+		{"interface", normaliseSet(strings.Split(string(r.Interface), ","))},
+		{"direction", string(r.Direction)},
+		{"ipprotocol", string(r.IPProtocol)},
+		{"protocol", normaliseAny(strings.ToUpper(string(r.Protocol)))},
+		{"source_net", normaliseAny(string(r.SourceNet))},
+		{"source_not", r.SourceNot.URLArgument()},
+		{"source_port", normalisePort(r.SourcePort)},
+		{"destination_net", normaliseAny(string(r.DestinationNet))},
+		{"destination_not", r.DestinationNot.URLArgument()},
+		{"destination_port", normalisePort(r.DestinationPort)},
+		{"gateway", string(r.Gateway)},
+		{"log", r.Log.URLArgument()},
+		{"categories", normaliseSet(categories)},
+		{"description", r.Description},
+	}
+}
+
+// normaliseSet sorts values and joins them with commas, dropping empty ones.
+func normaliseSet(values []string) string {
+	set := []string{}
+
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			set = append(set, value)
+		}
+	}
+
+	sort.Strings(set)
+
+	return strings.Join(set, ",")
+}
+
+func normaliseAny(value string) string {
+	if value == "" || strings.EqualFold(value, "any") {
+		return "any"
+	}
+
+	return value
+}
+
+func normalisePort(port *PortRange) string {
+	switch {
+	case port.IsAny():
+		return "any"
+	case port.Name == "" && port.From == port.To:
+		return NewPort(port.From).String()
+	}
+
+	return port.String()
+}
@@ -0,0 +1,190 @@
+package opnsense
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/netip"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// NptRule translates an IPv6 prefix to another of the same length (NPTv6).
+type NptRule struct {
+	UUID      *uuid.UUID `json:"uuid,omitempty"`
+	Enabled   Bool       `json:"enabled"`
+	Log       Bool       `json:"log"`
+	Sequence  Integer    `json:"sequence,omitempty"`
+	Interface Interface  `json:"interface,omitempty"`
+	// SourceNet is the internal prefix.
+	SourceNet NetworkOrAlias `json:"source_net,omitempty"`
+	// DestinationNet is the external prefix. It can be left empty when
+	// TrackInterface derives it from the prefix delegated to an interface.
+	DestinationNet NetworkOrAlias `json:"destination_net,omitempty"`
+	TrackInterface Interface      `json:"trackif,omitempty"`
+	Description    string         `json:"description,omitempty"`
+}
+
+// Validate checks the options of the rule before it is sent to OPNsense. Both
+// interfaces name a single interface and the prefixes, when set, are IPv6
+// prefixes of the same length.
+func (r *NptRule) Validate() error {
+	if strings.Contains(string(r.Interface), ",") {
+		return fmt.Errorf("%w: interface %q is not a single interface", ErrOpnsenseInvalidOption, r.Interface)
+	}
+
+	if strings.Contains(string(r.TrackInterface), ",") {
+		return fmt.Errorf("%w: trackif %q is not a single interface", ErrOpnsenseInvalidOption, r.TrackInterface)
+	}
+
+	source, err := nptPrefix("source_net", r.SourceNet)
+	if err != nil {
+		return err
+	}
+
+	destination, err := nptPrefix("destination_net", r.DestinationNet)
+	if err != nil {
+		return err
+	}
+
+	if source.IsValid() && destination.IsValid() && source.Bits() != destination.Bits() {
+		return fmt.Errorf(
+			"%w: source_net %q and destination_net %q differ in length",
+			ErrOpnsenseInvalidOption, r.SourceNet, r.DestinationNet,
+		)
+	}
+
+	return nil
+}
+
+// nptPrefix parses the prefix in field, returning the zero prefix if it is
+// empty.
+func nptPrefix(field string, network NetworkOrAlias) (netip.Prefix, error) {
+	if network == "" {
+		return netip.Prefix{}, nil
+	}
+
+	prefix, err := netip.ParsePrefix(string(network))
+	if err != nil || !prefix.Addr().Is6() {
+		return netip.Prefix{}, fmt.Errorf("%w: %s %q is not an IPv6 prefix", ErrOpnsenseInvalidOption, field, network)
+	}
+
+	return prefix, nil
+}
+
+func (r *NptRule) setUUID(id uuid.UUID) {
+	r.UUID = &id
+}
+
+func (c *Client) nptRuleResource() *Resource[NptRule, NptRule] {
+	return NewResource[NptRule, NptRule](c, ResourceConfig{
+		Module:      "firewall/npt",
+		Key:         "rule",
+		Suffix:      "Rule",
+		Reconfigure: "firewall/filter/apply",
+	})
+}
+
+func (c *Client) FirewallNptRuleGet(uuid uuid.UUID) (*NptRule, error) {
+	return c.FirewallNptRuleGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallNptRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*NptRule, error) {
+	return c.nptRuleResource().Get(ctx, uuid)
+}
+
+func (c *Client) FirewallNptRuleSearch() ([]*NptRule, error) {
+	return c.FirewallNptRuleSearchCtx(context.Background())
+}
+
+func (c *Client) FirewallNptRuleSearchCtx(ctx context.Context) ([]*NptRule, error) {
+	return collect(c.FirewallNptRuleSearchAll(ctx, SearchOptions{}))
+}
+
+func (c *Client) FirewallNptRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*NptRule, error] {
+	return SearchAll[*NptRule](ctx, c, c.nptRuleResource().searchEndpoint(), opts)
+}
+
+func (c *Client) FirewallNptRuleAdd(rule *NptRule) (*uuid.UUID, error) {
+	return c.FirewallNptRuleAddCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallNptRuleAddCtx(ctx context.Context, rule *NptRule) (*uuid.UUID, error) {
+	err := rule.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("FirewallNptRuleAdd failed: %w", err)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallNptRuleAdd failed: %w", err)
+	}
+
+	err = c.checkInterfaces(ctx, rule.TrackInterface)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallNptRuleAdd failed: %w", err)
+	}
+
+	response, err := c.nptRuleResource().Add(ctx, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallNptRuleAdd failed: %w", err)
+	}
+
+	rule.UUID = response.UUID
+
+	return response.UUID, nil
+}
+
+func (c *Client) FirewallNptRuleSet(rule *NptRule) error {
+	return c.FirewallNptRuleSetCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallNptRuleSetCtx(ctx context.Context, rule *NptRule) error {
+	err := rule.Validate()
+	if err != nil {
+		return fmt.Errorf("FirewallNptRuleSet failed: %w", err)
+	}
+
+	if rule.UUID == nil {
+		return fmt.Errorf("FirewallNptRuleSet failed: %w", ErrOpnsenseMissingUUID)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return fmt.Errorf("FirewallNptRuleSet failed: %w", err)
+	}
+
+	err = c.checkInterfaces(ctx, rule.TrackInterface)
+	if err != nil {
+		return fmt.Errorf("FirewallNptRuleSet failed: %w", err)
+	}
+
+	_, err = c.nptRuleResource().Set(ctx, *rule.UUID, *rule)
+	if err != nil {
+		return fmt.Errorf("FirewallNptRuleSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallNptRuleDelete(uuid uuid.UUID) error {
+	return c.FirewallNptRuleDeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallNptRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.nptRuleResource().Delete(ctx, uuid)
+	if err != nil {
+		return fmt.Errorf("FirewallNptRuleDelete failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallNptRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.FirewallNptRuleToggleCtx(context.Background(), uuid, enabled)
+}
+
+func (c *Client) FirewallNptRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.nptRuleResource().Toggle(ctx, uuid, enabled)
+}
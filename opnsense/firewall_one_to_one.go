@@ -0,0 +1,181 @@
+package opnsense
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// OneToOneType is the kind of translation a one-to-one NAT rule does.
+type OneToOneType string
+
+const (
+	// OneToOneBinat translates in both directions.
+	OneToOneBinat OneToOneType = "binat"
+	// OneToOneNat only translates outbound traffic.
+	OneToOneNat OneToOneType = "nat"
+)
+
+func (t OneToOneType) Valid() bool {
+	switch t {
+	case OneToOneBinat, OneToOneNat:
+		return true
+	}
+
+	return false
+}
+
+func (t *OneToOneType) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, map[string]string{
+		"binat": string(OneToOneBinat),
+		"nat":   string(OneToOneNat),
+	})
+	if err != nil {
+		return err
+	}
+
+	*t = OneToOneType(option)
+
+	return nil
+}
+
+func (t OneToOneType) MarshalJSON() ([]byte, error) {
+	return optionToJSON("type", string(t), t.Valid())
+}
+
+// OneToOneRule maps an external address or subnet to an internal one of the
+// same size.
+type OneToOneRule struct {
+	UUID      *uuid.UUID   `json:"uuid,omitempty"`
+	Enabled   Bool         `json:"enabled"`
+	Log       Bool         `json:"log"`
+	Sequence  Integer      `json:"sequence,omitempty"`
+	Interface Interface    `json:"interface,omitempty"`
+	Type      OneToOneType `json:"type,omitempty"`
+	// SourceNet is the internal address or subnet.
+	SourceNet      NetworkOrAlias `json:"source_net,omitempty"`
+	SourceNot      Bool           `json:"source_not"`
+	DestinationNet NetworkOrAlias `json:"destination_net,omitempty"`
+	DestinationNot Bool           `json:"destination_not"`
+	// External is the address or subnet SourceNet is translated to.
+	External      string `json:"external,omitempty"`
+	NatReflection string `json:"natreflection,omitempty"`
+	Description   string `json:"description,omitempty"`
+}
+
+// Validate checks the options of the rule before it is sent to OPNsense.
+func (r *OneToOneRule) Validate() error {
+	if r.Type != "" && !r.Type.Valid() {
+		return fmt.Errorf("%w: type %q", ErrOpnsenseInvalidOption, r.Type)
+	}
+
+	return nil
+}
+
+func (r *OneToOneRule) setUUID(id uuid.UUID) {
+	r.UUID = &id
+}
+
+func (c *Client) oneToOneRuleResource() *Resource[OneToOneRule, OneToOneRule] {
+	return NewResource[OneToOneRule, OneToOneRule](c, ResourceConfig{
+		Module:      "firewall/one_to_one",
+		Key:         "rule",
+		Suffix:      "Rule",
+		Reconfigure: "firewall/filter/apply",
+	})
+}
+
+func (c *Client) FirewallOneToOneRuleGet(uuid uuid.UUID) (*OneToOneRule, error) {
+	return c.FirewallOneToOneRuleGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallOneToOneRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*OneToOneRule, error) {
+	return c.oneToOneRuleResource().Get(ctx, uuid)
+}
+
+func (c *Client) FirewallOneToOneRuleSearch() ([]*OneToOneRule, error) {
+	return c.FirewallOneToOneRuleSearchCtx(context.Background())
+}
+
+func (c *Client) FirewallOneToOneRuleSearchCtx(ctx context.Context) ([]*OneToOneRule, error) {
+	return collect(c.FirewallOneToOneRuleSearchAll(ctx, SearchOptions{}))
+}
+
+func (c *Client) FirewallOneToOneRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*OneToOneRule, error] {
+	return SearchAll[*OneToOneRule](ctx, c, c.oneToOneRuleResource().searchEndpoint(), opts)
+}
+
+func (c *Client) FirewallOneToOneRuleAdd(rule *OneToOneRule) (*uuid.UUID, error) {
+	return c.FirewallOneToOneRuleAddCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallOneToOneRuleAddCtx(ctx context.Context, rule *OneToOneRule) (*uuid.UUID, error) {
+	err := rule.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("FirewallOneToOneRuleAdd failed: %w", err)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallOneToOneRuleAdd failed: %w", err)
+	}
+
+	response, err := c.oneToOneRuleResource().Add(ctx, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallOneToOneRuleAdd failed: %w", err)
+	}
+
+	rule.UUID = response.UUID
+
+	return response.UUID, nil
+}
+
+func (c *Client) FirewallOneToOneRuleSet(rule *OneToOneRule) error {
+	return c.FirewallOneToOneRuleSetCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallOneToOneRuleSetCtx(ctx context.Context, rule *OneToOneRule) error {
+	err := rule.Validate()
+	if err != nil {
+		return fmt.Errorf("FirewallOneToOneRuleSet failed: %w", err)
+	}
+
+	if rule.UUID == nil {
+		return fmt.Errorf("FirewallOneToOneRuleSet failed: %w", ErrOpnsenseMissingUUID)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return fmt.Errorf("FirewallOneToOneRuleSet failed: %w", err)
+	}
+
+	_, err = c.oneToOneRuleResource().Set(ctx, *rule.UUID, *rule)
+	if err != nil {
+		return fmt.Errorf("FirewallOneToOneRuleSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallOneToOneRuleDelete(uuid uuid.UUID) error {
+	return c.FirewallOneToOneRuleDeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallOneToOneRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.oneToOneRuleResource().Delete(ctx, uuid)
+	if err != nil {
+		return fmt.Errorf("FirewallOneToOneRuleDelete failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallOneToOneRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.FirewallOneToOneRuleToggleCtx(context.Background(), uuid, enabled)
+}
+
+func (c *Client) FirewallOneToOneRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.oneToOneRuleResource().Toggle(ctx, uuid, enabled)
+}
@@ -0,0 +1,280 @@
+package opnsense
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// filterRuleSequenceStep is the distance between the sequences handed
+	// out to moved rules, leaving room to insert rules in between later.
+	filterRuleSequenceStep = 100
+	// filterRuleSequenceMax is the largest sequence OPNsense accepts.
+	filterRuleSequenceMax = 99999
+)
+
+// filterRuleSequence is the part of a filter rule written when reordering.
+// OPNsense only changes the fields that are sent.
+type filterRuleSequence struct {
+	Sequence Integer `json:"sequence"`
+}
+
+type filterRuleOrder struct {
+	UUID     uuid.UUID `json:"uuid"`
+	Sequence Integer   `json:"sequence"`
+}
+
+func (c *Client) filterRuleSequenceResource() *Resource[FilterRule, filterRuleSequence] {
+	return NewResource[FilterRule, filterRuleSequence](c, filterRuleResourceConfig)
+}
+
+func (c *Client) FirewallFilterRuleMoveBefore(id uuid.UUID, anchor uuid.UUID) error {
+	return c.FirewallFilterRuleMoveBeforeCtx(context.Background(), id, anchor)
+}
+
+// FirewallFilterRuleMoveBeforeCtx changes the sequence of the rule id so it
+// is evaluated right before anchor. Usually only the moved rule is written;
+// other rules are renumbered only if there is no free sequence in front of
+// anchor.
+func (c *Client) FirewallFilterRuleMoveBeforeCtx(ctx context.Context, id uuid.UUID, anchor uuid.UUID) error {
+	rules, err := c.filterRuleOrder(ctx)
+	if err != nil {
+		return fmt.Errorf("FirewallFilterRuleMoveBefore failed: %w", err)
+	}
+
+	moved := -1
+	order := make([]filterRuleOrder, 0, len(rules))
+
+	for i, rule := range rules {
+		if uuid.Equal(rule.UUID, id) {
+			moved = i
+		} else {
+			order = append(order, rule)
+		}
+	}
+
+	if moved < 0 {
+		return fmt.Errorf("FirewallFilterRuleMoveBefore failed: rule %s: %w", id, ErrOpnsenseNotFound)
+	}
+
+	if uuid.Equal(id, anchor) {
+		return nil
+	}
+
+	at := -1
+
+	for i, rule := range order {
+		if uuid.Equal(rule.UUID, anchor) {
+			at = i
+		}
+	}
+
+	if at < 0 {
+		return fmt.Errorf("FirewallFilterRuleMoveBefore failed: rule %s: %w", anchor, ErrOpnsenseNotFound)
+	}
+
+	order = append(order[:at], append([]filterRuleOrder{rules[moved]}, order[at:]...)...)
+
+	err = c.writeFilterRuleOrder(ctx, order)
+	if err != nil {
+		return fmt.Errorf("FirewallFilterRuleMoveBefore failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallFilterRulesReorder(order []uuid.UUID) error {
+	return c.FirewallFilterRulesReorderCtx(context.Background(), order)
+}
+
+// FirewallFilterRulesReorderCtx changes the sequences of the rules in order
+// so they are evaluated in that order. The rules in order take the places
+// they held among the other rules, whose order is left alone. The rules on
+// the longest run that is already in sequence keep theirs, the others get
+// sequences in the gaps between them, or every rule is renumbered if a gap
+// is too small.
+func (c *Client) FirewallFilterRulesReorderCtx(ctx context.Context, order []uuid.UUID) error {
+	rules, err := c.filterRuleOrder(ctx)
+	if err != nil {
+		return fmt.Errorf("FirewallFilterRulesReorder failed: %w", err)
+	}
+
+	byUUID := make(map[uuid.UUID]filterRuleOrder, len(rules))
+	for _, rule := range rules {
+		byUUID[rule.UUID] = rule
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(order))
+
+	for _, id := range order {
+		if _, ok := byUUID[id]; !ok {
+			return fmt.Errorf("FirewallFilterRulesReorder failed: rule %s: %w", id, ErrOpnsenseNotFound)
+		}
+
+		if wanted[id] {
+			return fmt.Errorf("FirewallFilterRulesReorder failed: rule %s: %w", id, ErrOpnsenseDuplicateUUID)
+		}
+
+		wanted[id] = true
+	}
+
+	reordered := make([]filterRuleOrder, 0, len(rules))
+	next := 0
+
+	for _, rule := range rules {
+		if wanted[rule.UUID] {
+			rule = byUUID[order[next]]
+			next++
+		}
+
+		reordered = append(reordered, rule)
+	}
+
+	err = c.writeFilterRuleOrder(ctx, reordered)
+	if err != nil {
+		return fmt.Errorf("FirewallFilterRulesReorder failed: %w", err)
+	}
+
+	return nil
+}
+
+// filterRuleOrder returns every filter rule in the order OPNsense evaluates
+// them.
+func (c *Client) filterRuleOrder(ctx context.Context) ([]filterRuleOrder, error) {
+	rules, err := collect(SearchAll[filterRuleOrder](ctx, c, c.filterRuleResource().searchEndpoint(), SearchOptions{
+		Sort: map[string]string{"sequence": "asc"},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Sequence < rules[j].Sequence
+	})
+
+	return rules, nil
+}
+
+// writeFilterRuleOrder sets the sequences planned for order on the rules
+// whose sequence changes.
+func (c *Client) writeFilterRuleOrder(ctx context.Context, order []filterRuleOrder) error {
+	current := make([]Integer, len(order))
+	for i, rule := range order {
+		current[i] = rule.Sequence
+	}
+
+	for i, sequence := range planSequences(current) {
+		if sequence == order[i].Sequence {
+			continue
+		}
+
+		_, err := c.filterRuleSequenceResource().Set(ctx, order[i].UUID, filterRuleSequence{Sequence: sequence})
+		if err != nil {
+			return fmt.Errorf("setting sequence of %s: %w", order[i].UUID, err)
+		}
+	}
+
+	return nil
+}
+
+// planSequences returns strictly ascending sequences for items currently at
+// the given sequences. The longest ascending subsequence is kept and the
+// remaining items are spread over the gaps around it, filterRuleSequenceStep
+// apart where there is room. If a gap is too small, every item is renumbered.
+func planSequences(current []Integer) []Integer {
+	keep := longestAscending(current)
+	planned := make([]Integer, len(current))
+
+	lower := Integer(0)
+
+	for i := 0; i < len(current); {
+		if keep[i] {
+			planned[i] = current[i]
+			lower = current[i]
+			i++
+
+			continue
+		}
+
+		end := i
+		for end < len(current) && !keep[end] {
+			end++
+		}
+
+		run := Integer(end - i)
+		gap := Integer(filterRuleSequenceStep)
+
+		if end < len(current) {
+			gap = min(gap, (current[end]-lower)/(run+1))
+		}
+
+		if gap < 1 || lower+gap*run > filterRuleSequenceMax {
+			return renumberSequences(len(current))
+		}
+
+		for j := i; j < end; j++ {
+			lower += gap
+			planned[j] = lower
+		}
+
+		i = end
+	}
+
+	return planned
+}
+
+// renumberSequences spreads n items evenly, filterRuleSequenceStep apart if
+// they fit.
+func renumberSequences(n int) []Integer {
+	step := max(1, min(filterRuleSequenceStep, filterRuleSequenceMax/max(n, 1)))
+	planned := make([]Integer, n)
+
+	for i := range planned {
+		planned[i] = Integer((i + 1) * step)
+	}
+
+	return planned
+}
+
+// longestAscending marks a longest strictly ascending subsequence of values,
+// preferring the earliest of equal values so the others can follow it.
+func longestAscending(values []Integer) []bool {
+	// Walking backwards, heads[k] is the index of the largest value starting
+	// an ascending subsequence of length k+1 and next links each index to the
+	// one after it in its subsequence.
+	heads := []int{}
+	next := make([]int, len(values))
+
+	for i := len(values) - 1; i >= 0; i-- {
+		value := values[i]
+		k := sort.Search(len(heads), func(k int) bool {
+			return values[heads[k]] <= value
+		})
+
+		next[i] = -1
+		if k > 0 {
+			next[i] = heads[k-1]
+		}
+
+		if k == len(heads) {
+			heads = append(heads, i)
+		} else {
+			heads[k] = i
+		}
+	}
+
+	keep := make([]bool, len(values))
+
+	if len(heads) == 0 {
+		return keep
+	}
+
+	for i := heads[len(heads)-1]; i >= 0; i = next[i] {
+		keep[i] = true
+	}
+
+	return keep
+}
@@ -0,0 +1,64 @@
+package opnsense
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanSequences(t *testing.T) {
+	tests := map[string]struct {
+		current []Integer
+		planned []Integer
+	}{
+		"in order": {
+			current: []Integer{100, 200, 300},
+			planned: []Integer{100, 200, 300},
+		},
+		"moved to the front": {
+			current: []Integer{300, 100, 200},
+			planned: []Integer{50, 100, 200},
+		},
+		"moved between": {
+			current: []Integer{100, 300, 200, 400},
+			planned: []Integer{100, 300, 350, 400},
+		},
+		"moved to the end": {
+			current: []Integer{200, 300, 100},
+			planned: []Integer{200, 300, 400},
+		},
+		"one moved instead of two": {
+			current: []Integer{100, 500, 600, 200},
+			planned: []Integer{100, 500, 600, 700},
+		},
+		"several in a gap": {
+			current: []Integer{100, 500, 600, 200, 300, 400, 900},
+			planned: []Integer{100, 133, 166, 200, 300, 400, 900},
+		},
+		"duplicates": {
+			current: []Integer{1, 1, 1},
+			planned: []Integer{1, 101, 201},
+		},
+		"no room": {
+			current: []Integer{5, 1, 2},
+			planned: []Integer{100, 200, 300},
+		},
+		"empty": {
+			current: []Integer{},
+			planned: []Integer{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.planned, planSequences(tt.current))
+		})
+	}
+}
+
+func TestRenumberSequencesFitsMax(t *testing.T) {
+	planned := renumberSequences(2000)
+
+	assert.Equal(t, Integer(49), planned[0])
+	assert.LessOrEqual(t, planned[len(planned)-1], Integer(filterRuleSequenceMax))
+}
@@ -0,0 +1,328 @@
+package opnsense
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// FilterRuleScope selects the filter rules a reconciliation owns. Rules
+// outside of it are never changed. At least one of the fields has to be set,
+// if both are, rules have to match both.
+type FilterRuleScope struct {
+	// Category selects the rules in the category with this UUID. It is added
+	// to the desired rules that are not in it yet.
+	Category *uuid.UUID
+	// DescriptionPrefix selects the rules whose description starts with it.
+	// Every desired rule has to have a description with this prefix.
+	DescriptionPrefix string
+}
+
+func (s FilterRuleScope) contains(rule *FilterRule) bool {
+	if s.Category != nil && (rule.Categories == nil || !rule.Categories.Contains(*s.Category)) {
+		return false
+	}
+
+	return strings.HasPrefix(rule.Description, s.DescriptionPrefix)
+}
+
+// FilterRulePlan are the changes that turn the rules in Scope into the
+// desired ones. Rules are matched by their description, so renaming a rule
+// deletes it and creates a new one.
+type FilterRulePlan struct {
+	Scope FilterRuleScope
+	// Desired are the desired rules in order, with the UUID of the rule they
+	// match if there is one.
+	Desired []*FilterRule
+	Create  []*FilterRule
+	// Update are the desired rules that differ from the rule they match.
+	Update []*FilterRule
+	// Changes are the fields that differ for each updated rule, keyed by its
+	// UUID.
+	Changes map[uuid.UUID][]FilterRuleChange
+	Delete  []*FilterRule
+	// Reorder is set if the rules have to be renumbered to be evaluated in
+	// the desired order.
+	Reorder bool
+}
+
+// Empty reports whether the rules are already as desired.
+func (p *FilterRulePlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0 && !p.Reorder
+}
+
+func (c *Client) FirewallFilterRulePlan(scope FilterRuleScope, desired []FilterRule) (*FilterRulePlan, error) {
+	return c.FirewallFilterRulePlanCtx(context.Background(), scope, desired)
+}
+
+// FirewallFilterRulePlanCtx compares desired with the rules in scope without
+// changing anything. The position of a desired rule decides its order, its
+// Sequence is ignored. Fields left empty in a desired rule are not sent to
+// OPNsense and do not cause updates. The exception are the Bool fields, as
+// false cannot be told apart from unset: they are always compared and sent,
+// so desired rules set Enabled and Quick to keep a rule on and quick.
+func (c *Client) FirewallFilterRulePlanCtx(
+	ctx context.Context,
+	scope FilterRuleScope,
+	desired []FilterRule,
+) (*FilterRulePlan, error) {
+	if scope.Category == nil && scope.DescriptionPrefix == "" {
+		return nil, fmt.Errorf("FirewallFilterRulePlan failed: %w", ErrOpnsenseInvalidScope)
+	}
+
+	plan := &FilterRulePlan{Scope: scope, Changes: map[uuid.UUID][]FilterRuleChange{}}
+	seen := map[string]bool{}
+
+	for i := range desired {
+		rule := desired[i]
+
+		if !strings.HasPrefix(rule.Description, scope.DescriptionPrefix) {
+			return nil, fmt.Errorf("FirewallFilterRulePlan failed: %w: %q", ErrOpnsenseOutOfScope, rule.Description)
+		}
+
+		if seen[rule.Description] {
+			return nil, fmt.Errorf("FirewallFilterRulePlan failed: %w: %q", ErrOpnsenseDuplicateRule, rule.Description)
+		}
+
+		seen[rule.Description] = true
+
+		err := rule.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("FirewallFilterRulePlan failed: %w", err)
+		}
+
+		if scope.Category != nil && (rule.Categories == nil || !rule.Categories.Contains(*scope.Category)) {
+			categories := Categories{*scope.Category}
+			if rule.Categories != nil {
+				categories = append(append(Categories{}, *rule.Categories...), *scope.Category)
+			}
+
+			rule.Categories = &categories
+		}
+
+		rule.UUID = nil
+		rule.Sequence = 0
+		plan.Desired = append(plan.Desired, &rule)
+	}
+
+	current, err := c.filterRulesInScope(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallFilterRulePlan failed: %w", err)
+	}
+
+	byDescription := map[string]*FilterRule{}
+
+	for _, rule := range current {
+		if _, ok := byDescription[rule.Description]; ok || !seen[rule.Description] {
+			plan.Delete = append(plan.Delete, rule)
+
+			continue
+		}
+
+		byDescription[rule.Description] = rule
+	}
+
+	sequences := []Integer{}
+
+	for _, rule := range plan.Desired {
+		existing, ok := byDescription[rule.Description]
+		if !ok {
+			plan.Create = append(plan.Create, rule)
+
+			continue
+		}
+
+		rule.UUID = existing.UUID
+		rule.Sequence = existing.Sequence
+		sequences = append(sequences, existing.Sequence)
+
+		changes := filterRuleChanges(existing, rule)
+		if len(changes) > 0 {
+			plan.Update = append(plan.Update, rule)
+			plan.Changes[*rule.UUID] = changes
+		}
+	}
+
+	plan.Reorder = len(plan.Create) > 0 && len(plan.Desired) > 1 || !ascending(sequences)
+
+	return plan, nil
+}
+
+func (c *Client) FirewallFilterRulePlanApply(plan *FilterRulePlan, check FirewallFilterCheck) error {
+	return c.FirewallFilterRulePlanApplyCtx(context.Background(), plan, check)
+}
+
+// FirewallFilterRulePlanApplyCtx makes the changes of plan in a
+// FirewallFilterTransaction, so they are rolled back if check fails. Rules
+// are deleted, updated and created in that order before being reordered.
+// Created rules get their UUIDs set in plan.Desired.
+func (c *Client) FirewallFilterRulePlanApplyCtx(ctx context.Context, plan *FilterRulePlan, check FirewallFilterCheck) error {
+	if plan.Empty() {
+		return nil
+	}
+
+	return c.FirewallFilterTransactionCtx(ctx, func(ctx context.Context) error {
+		for _, rule := range plan.Delete {
+			err := c.FirewallFilterRuleDeleteCtx(ctx, *rule.UUID)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, rule := range plan.Update {
+			err := c.FirewallFilterRuleSetCtx(ctx, rule)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, rule := range plan.Create {
+			_, err := c.FirewallFilterRuleAddCtx(ctx, rule)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !plan.Reorder {
+			return nil
+		}
+
+		return c.reorderFilterRules(ctx, plan.Desired)
+	}, check)
+}
+
+func (c *Client) FirewallFilterRuleReconcile(
+	scope FilterRuleScope,
+	desired []FilterRule,
+	check FirewallFilterCheck,
+) (*FilterRulePlan, error) {
+	return c.FirewallFilterRuleReconcileCtx(context.Background(), scope, desired, check)
+}
+
+// FirewallFilterRuleReconcileCtx plans the changes from the rules in scope to
+// desired and applies them, returning the plan that was applied.
+func (c *Client) FirewallFilterRuleReconcileCtx(
+	ctx context.Context,
+	scope FilterRuleScope,
+	desired []FilterRule,
+	check FirewallFilterCheck,
+) (*FilterRulePlan, error) {
+	plan, err := c.FirewallFilterRulePlanCtx(ctx, scope, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.FirewallFilterRulePlanApplyCtx(ctx, plan, check)
+	if err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// filterRulesInScope fetches the rules in scope with get, so they can be
+// compared with the desired ones field by field.
+func (c *Client) filterRulesInScope(ctx context.Context, scope FilterRuleScope) ([]*FilterRule, error) {
+	opts := SearchOptions{SearchPhrase: scope.DescriptionPrefix}
+	if scope.Category != nil {
+		opts.Filters = map[string]interface{}{
+			"category": []string{scope.Category.String()},
+		}
+	}
+
+	type row struct {
+		UUID        uuid.UUID `json:"uuid"`
+		Description string    `json:"description"`
+	}
+
+	rows, err := collect(SearchAll[row](ctx, c, c.filterRuleResource().searchEndpoint(), opts))
+	if err != nil {
+		return nil, err
+	}
+
+	rules := []*FilterRule{}
+
+	for _, row := range rows {
+		if !strings.HasPrefix(row.Description, scope.DescriptionPrefix) {
+			continue
+		}
+
+		rule, err := c.FirewallFilterRuleGetCtx(ctx, row.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if scope.contains(rule) {
+			rules = append(rules, rule)
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Sequence < rules[j].Sequence
+	})
+
+	return rules, nil
+}
+
+// reorderFilterRules renumbers rules, which all have UUIDs, to be evaluated
+// in the order given.
+func (c *Client) reorderFilterRules(ctx context.Context, rules []*FilterRule) error {
+	current, err := c.filterRuleOrder(ctx)
+	if err != nil {
+		return err
+	}
+
+	sequences := make(map[uuid.UUID]Integer, len(current))
+	for _, rule := range current {
+		sequences[rule.UUID] = rule.Sequence
+	}
+
+	order := make([]filterRuleOrder, len(rules))
+	for i, rule := range rules {
+		order[i] = filterRuleOrder{UUID: *rule.UUID, Sequence: sequences[*rule.UUID]}
+	}
+
+	return c.writeFilterRuleOrder(ctx, order)
+}
+
+// filterRuleChanges returns the differences between current and desired in
+// the fields sent for desired.
+func filterRuleChanges(current, desired *FilterRule) []FilterRuleChange {
+	sent, err := filterRuleFields(desired)
+	if err != nil {
+		return current.Diff(desired)
+	}
+
+	changes := []FilterRuleChange{}
+
+	for _, change := range current.Diff(desired) {
+		if _, ok := sent[change.Field]; ok {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// filterRuleFields returns the fields of rule the way they are sent to
+// OPNsense, leaving out the UUID and sequence that are not compared.
+func filterRuleFields(rule *FilterRule) (map[string]interface{}, error) {
+	sent := *rule
+	sent.UUID = nil
+	sent.Sequence = 0
+
+	return StructToMap2(sent)
+}
+
+func ascending(sequences []Integer) bool {
+	for i := 1; i < len(sequences); i++ {
+		if sequences[i] <= sequences[i-1] {
+			return false
+		}
+	}
+
+	return true
+}
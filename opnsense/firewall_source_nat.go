@@ -0,0 +1,164 @@
+package opnsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// SourceNatRule is an outbound NAT rule, translating the source of traffic
+// leaving through Interface. NoNat exempts the matching traffic from
+// translation, including by the rules after it.
+type SourceNatRule struct {
+	UUID            *uuid.UUID     `json:"uuid,omitempty"`
+	Enabled         Bool           `json:"enabled"`
+	NoNat           Bool           `json:"nonat"`
+	Sequence        Integer        `json:"sequence,omitempty"`
+	Interface       Interface      `json:"interface,omitempty"`
+	IPProtocol      IPProtocol     `json:"ipprotocol,omitempty"`
+	Protocol        Protocol       `json:"protocol,omitempty"`
+	SourceNet       NetworkOrAlias `json:"source_net,omitempty"`
+	SourceNot       Bool           `json:"source_not"`
+	SourcePort      *PortRange     `json:"source_port,omitempty"`
+	DestinationNet  NetworkOrAlias `json:"destination_net,omitempty"`
+	DestinationNot  Bool           `json:"destination_not"`
+	DestinationPort *PortRange     `json:"destination_port,omitempty"`
+	// Target is the address the source is translated to, empty for the
+	// address of the interface.
+	Target        NetworkOrAlias `json:"target,omitempty"`
+	TargetPort    *PortRange     `json:"target_port,omitempty"`
+	StaticNatPort Bool           `json:"staticnatport"`
+	Log           Bool           `json:"log"`
+	Description   string         `json:"description,omitempty"`
+}
+
+// Validate checks the options of the rule before it is sent to OPNsense.
+func (r *SourceNatRule) Validate() error {
+	if r.IPProtocol != "" && !r.IPProtocol.Valid() {
+		return fmt.Errorf("%w: ipprotocol %q", ErrOpnsenseInvalidOption, r.IPProtocol)
+	}
+
+	return nil
+}
+
+// sourceNatRule drops the JSON methods of SourceNatRule.
+type sourceNatRule SourceNatRule
+
+// MarshalJSON refuses to encode a rule that does not pass Validate.
+func (r SourceNatRule) MarshalJSON() ([]byte, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(sourceNatRule(r))
+}
+
+func (r *SourceNatRule) setUUID(id uuid.UUID) {
+	r.UUID = &id
+}
+
+func (c *Client) sourceNatRuleResource() *Resource[SourceNatRule, SourceNatRule] {
+	return NewResource[SourceNatRule, SourceNatRule](c, ResourceConfig{
+		Module:      "firewall/source_nat",
+		Key:         "rule",
+		Suffix:      "Rule",
+		Reconfigure: "firewall/filter/apply",
+	})
+}
+
+func (c *Client) FirewallSourceNatRuleGet(uuid uuid.UUID) (*SourceNatRule, error) {
+	return c.FirewallSourceNatRuleGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallSourceNatRuleGetCtx(ctx context.Context, uuid uuid.UUID) (*SourceNatRule, error) {
+	return c.sourceNatRuleResource().Get(ctx, uuid)
+}
+
+func (c *Client) FirewallSourceNatRuleSearch() ([]*SourceNatRule, error) {
+	return c.FirewallSourceNatRuleSearchCtx(context.Background())
+}
+
+func (c *Client) FirewallSourceNatRuleSearchCtx(ctx context.Context) ([]*SourceNatRule, error) {
+	return collect(c.FirewallSourceNatRuleSearchAll(ctx, SearchOptions{}))
+}
+
+func (c *Client) FirewallSourceNatRuleSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*SourceNatRule, error] {
+	return SearchAll[*SourceNatRule](ctx, c, c.sourceNatRuleResource().searchEndpoint(), opts)
+}
+
+func (c *Client) FirewallSourceNatRuleAdd(rule *SourceNatRule) (*uuid.UUID, error) {
+	return c.FirewallSourceNatRuleAddCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallSourceNatRuleAddCtx(ctx context.Context, rule *SourceNatRule) (*uuid.UUID, error) {
+	err := rule.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("FirewallSourceNatRuleAdd failed: %w", err)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallSourceNatRuleAdd failed: %w", err)
+	}
+
+	response, err := c.sourceNatRuleResource().Add(ctx, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("FirewallSourceNatRuleAdd failed: %w", err)
+	}
+
+	rule.UUID = response.UUID
+
+	return response.UUID, nil
+}
+
+func (c *Client) FirewallSourceNatRuleSet(rule *SourceNatRule) error {
+	return c.FirewallSourceNatRuleSetCtx(context.Background(), rule)
+}
+
+func (c *Client) FirewallSourceNatRuleSetCtx(ctx context.Context, rule *SourceNatRule) error {
+	err := rule.Validate()
+	if err != nil {
+		return fmt.Errorf("FirewallSourceNatRuleSet failed: %w", err)
+	}
+
+	if rule.UUID == nil {
+		return fmt.Errorf("FirewallSourceNatRuleSet failed: %w", ErrOpnsenseMissingUUID)
+	}
+
+	err = c.checkInterfaces(ctx, rule.Interface)
+	if err != nil {
+		return fmt.Errorf("FirewallSourceNatRuleSet failed: %w", err)
+	}
+
+	_, err = c.sourceNatRuleResource().Set(ctx, *rule.UUID, *rule)
+	if err != nil {
+		return fmt.Errorf("FirewallSourceNatRuleSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallSourceNatRuleDelete(uuid uuid.UUID) error {
+	return c.FirewallSourceNatRuleDeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) FirewallSourceNatRuleDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.sourceNatRuleResource().Delete(ctx, uuid)
+	if err != nil {
+		return fmt.Errorf("FirewallSourceNatRuleDelete failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) FirewallSourceNatRuleToggle(uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.FirewallSourceNatRuleToggleCtx(context.Background(), uuid, enabled)
+}
+
+func (c *Client) FirewallSourceNatRuleToggleCtx(ctx context.Context, uuid uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	return c.sourceNatRuleResource().Toggle(ctx, uuid, enabled)
+}
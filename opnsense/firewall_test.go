@@ -0,0 +1,164 @@
+package opnsense
+
+import (
+	"encoding/json"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterRuleOptionsUnmarshal(t *testing.T) {
+	tests := map[string]struct {
+		input      string
+		action     Action
+		direction  Direction
+		ipprotocol IPProtocol
+		gateway    Gateway
+	}{
+		"get": {
+			input: `{
+				"action": {"pass": {"value": "Pass", "selected": 0}, "block": {"value": "Block", "selected": 1}},
+				"direction": {"in": {"value": "In", "selected": false}, "out": {"value": "Out", "selected": true}},
+				"ipprotocol": {"inet": {"value": "IPv4", "selected": 0}, "inet46": {"value": "IPv4+IPv6", "selected": 1}},
+				"gateway": {"": {"value": "default", "selected": 0}, "WAN_GW": {"value": "WAN_GW - 192.0.2.1", "selected": 1}}
+			}`,
+			action:     ActionBlock,
+			direction:  DirectionOut,
+			ipprotocol: IPProtocolInet46,
+			gateway:    "WAN_GW",
+		},
+		"search": {
+			input:      `{"action": "Reject", "direction": "In", "ipprotocol": "IPv6"}`,
+			action:     ActionReject,
+			direction:  DirectionIn,
+			ipprotocol: IPProtocolInet6,
+		},
+		"keys": {
+			input:      `{"action": "pass", "direction": "any", "ipprotocol": "inet", "gateway": "WAN_GW"}`,
+			action:     ActionPass,
+			direction:  DirectionAny,
+			ipprotocol: IPProtocolInet,
+			gateway:    "WAN_GW",
+		},
+		"nothing selected": {
+			input: `{"action": [], "direction": {"in": {"value": "In", "selected": 0}}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rule FilterRule
+
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &rule))
+			assert.Equal(t, tt.action, rule.Action)
+			assert.Equal(t, tt.direction, rule.Direction)
+			assert.Equal(t, tt.ipprotocol, rule.IPProtocol)
+			assert.Equal(t, tt.gateway, rule.Gateway)
+		})
+	}
+}
+
+func TestFilterRuleOptionsMarshal(t *testing.T) {
+	rule := FilterRule{
+		Action:     ActionBlock,
+		Direction:  DirectionIn,
+		IPProtocol: IPProtocolInet6,
+	}
+
+	data, err := json.Marshal(rule)
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(data, &fields))
+	assert.Equal(t, "block", fields["action"])
+	assert.Equal(t, "in", fields["direction"])
+	assert.Equal(t, "inet6", fields["ipprotocol"])
+
+	data, err = json.Marshal(FilterRule{})
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "action")
+
+	_, err = json.Marshal(FilterRule{Action: "allow"})
+	assert.ErrorIs(t, err, ErrOpnsenseInvalidOption)
+}
+
+func TestNatRuleMarshalValidates(t *testing.T) {
+	_, err := json.Marshal(SourceNatRule{IPProtocol: "ipv4"})
+	assert.ErrorIs(t, err, ErrOpnsenseInvalidOption)
+
+	_, err = json.Marshal(DestinationNatRule{IPProtocol: "ipv4"})
+	assert.ErrorIs(t, err, ErrOpnsenseInvalidOption)
+
+	data, err := json.Marshal(SourceNatRule{IPProtocol: IPProtocolInet6})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"ipprotocol":"inet6"`)
+}
+
+func TestFilterRuleValidate(t *testing.T) {
+	assert.NoError(t, (&FilterRule{}).Validate())
+	assert.NoError(t, (&FilterRule{Action: ActionReject, Direction: DirectionOut, IPProtocol: IPProtocolInet46}).Validate())
+	assert.ErrorIs(t, (&FilterRule{Direction: "both"}).Validate(), ErrOpnsenseInvalidOption)
+	assert.ErrorIs(t, (&FilterRule{IPProtocol: "ipv4"}).Validate(), ErrOpnsenseInvalidOption)
+}
+
+func TestFilterRuleDiff(t *testing.T) {
+	web := uuid.NewV4()
+	managed := uuid.NewV4()
+
+	rule := FilterRule{
+		Enabled:         true,
+		Action:          ActionPass,
+		Interface:       "wan,lan",
+		Protocol:        "tcp",
+		SourceNet:       "any",
+		DestinationPort: &PortRange{From: 443, To: 443},
+		Categories:      &Categories{web, managed},
+		Description:     "web",
+	}
+
+	equivalent := FilterRule{
+		UUID:            &web,
+		Enabled:         true,
+		Action:          ActionPass,
+		Interface:       "lan,wan",
+		Protocol:        "TCP",
+		SourcePort:      &PortRange{},
+		DestinationPort: &PortRange{From: 443},
+		Categories:      &Categories{managed, web},
+		Description:     "web",
+	}
+
+	assert.Empty(t, rule.Diff(&equivalent))
+
+	changed := equivalent
+	changed.Action = ActionBlock
+	changed.Interface = "lan"
+	changed.DestinationPort = &PortRange{From: 8000, To: 8080}
+	changed.SourceNet = "10.0.0.0/8"
+
+	assert.Equal(t, []FilterRuleChange{
+		{Field: "action", From: "pass", To: "block"},
+		{Field: "interface", From: "lan,wan", To: "lan"},
+		{Field: "source_net", From: "any", To: "10.0.0.0/8"},
+		{Field: "destination_port", From: "443", To: "8000-8080"},
+	}, rule.Diff(&changed))
+
+	assert.Equal(t, `action: "pass" => "block"`, rule.Diff(&changed)[0].String())
+}
+
+func TestResolveCategories(t *testing.T) {
+	web := uuid.NewV4()
+	ssh := uuid.NewV4()
+	names := map[string]uuid.UUID{"web": web, "ssh": ssh}
+
+	field := func(s string) *string { return &s }
+
+	assert.Nil(t, resolveCategories(nil, names))
+	assert.Equal(t, &Categories{}, resolveCategories(field(""), names))
+	assert.Equal(t, &Categories{web, ssh}, resolveCategories(field("web, ssh"), names))
+	assert.Equal(t, &Categories{ssh, web}, resolveCategories(field("ssh,"+web.String()), names))
+	assert.Nil(t, resolveCategories(field("web,unknown"), names))
+}
@@ -0,0 +1,101 @@
+package opnsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FirewallFilterCheck decides whether the firewall is still healthy after
+// new filter rules were applied. Returning an error reverts the rules.
+type FirewallFilterCheck func(ctx context.Context, c *Client) error
+
+// FirewallFilterReachable is the default FirewallFilterCheck. It drops the
+// connections the client keeps open, whose states would survive rules
+// locking it out, and makes a request to the filter API over a new one.
+func FirewallFilterReachable(ctx context.Context, c *Client) error {
+	c.c.CloseIdleConnections()
+
+	_, err := Search[struct{}](ctx, c, "firewall/filter/searchRule", SearchOptions{RowCount: 1})
+
+	return err
+}
+
+func (c *Client) FirewallFilterTransaction(stage func(ctx context.Context) error, check FirewallFilterCheck) error {
+	return c.FirewallFilterTransactionCtx(context.Background(), stage, check)
+}
+
+// FirewallFilterTransactionCtx changes the filter rules without risking a
+// lockout:
+//
+//  1. a savepoint of the current rules is created,
+//  2. stage makes its changes, e.g. with FirewallFilterRuleAddCtx or the
+//     FirewallNatAPI methods,
+//  3. the rules are applied with the savepoint as rollback revision,
+//  4. check runs, FirewallFilterReachable if nil,
+//  5. the rollback is cancelled if check succeeded, otherwise the rules are
+//     reverted to the savepoint.
+//
+// If stage fails, its partial changes are reverted without being applied.
+// If the firewall cannot be reached to revert, OPNsense rolls back on its
+// own once the retention period of the savepoint, 60 seconds by default,
+// has passed. Errors after the savepoint wrap ErrOpnsenseFilterRolledBack
+// when the changes were reverted. If only cancelling the rollback fails,
+// the changes are live but will be reverted by OPNsense once the retention
+// period has passed, and the error wraps ErrOpnsenseFilterRollbackPending.
+func (c *Client) FirewallFilterTransactionCtx(
+	ctx context.Context,
+	stage func(ctx context.Context) error,
+	check FirewallFilterCheck,
+) error {
+	if check == nil {
+		check = FirewallFilterReachable
+	}
+
+	savepoint, err := c.FirewallFilterSavepointCtx(ctx)
+	if err != nil {
+		return fmt.Errorf("FirewallFilterTransaction savepoint failed: %w", err)
+	}
+
+	revision := savepoint.Revision
+
+	err = stage(ctx)
+	if err != nil {
+		return c.firewallFilterRollback(ctx, revision, fmt.Errorf("FirewallFilterTransaction stage failed: %w", err))
+	}
+
+	err = c.FirewallFilterApplyCtx(ctx, &revision)
+	if err != nil {
+		return c.firewallFilterRollback(ctx, revision, fmt.Errorf("FirewallFilterTransaction apply failed: %w", err))
+	}
+
+	err = check(ctx, c)
+	if err != nil {
+		return c.firewallFilterRollback(ctx, revision, fmt.Errorf("FirewallFilterTransaction check failed: %w", err))
+	}
+
+	_, err = c.FirewallFilterCancelRollbackCtx(ctx, revision)
+	if err != nil {
+		return fmt.Errorf(
+			"FirewallFilterTransaction cancel rollback failed, changes will revert to %s once the savepoint expires: %w: %w",
+			revision, ErrOpnsenseFilterRollbackPending, err,
+		)
+	}
+
+	return nil
+}
+
+// firewallFilterRollback reverts to revision after cause. The revert is
+// attempted even if ctx has been cancelled.
+func (c *Client) firewallFilterRollback(ctx context.Context, revision string, cause error) error {
+	ctx = context.WithoutCancel(ctx)
+
+	_, err := c.FirewallFilterRevertCtx(ctx, revision)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to revert firewall filter", "revision", revision, "error", err)
+
+		return errors.Join(cause, fmt.Errorf("FirewallFilterTransaction revert to %s failed: %w", revision, err))
+	}
+
+	return fmt.Errorf("%w: %w", ErrOpnsenseFilterRolledBack, cause)
+}
@@ -0,0 +1,150 @@
+package opnsense_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+	"github.com/kradalby/opnsense-go/opnsense/opnsensetest"
+)
+
+var errLockedOut = errors.New("locked out")
+
+func TestFirewallFilterTransaction(t *testing.T) {
+	addRule := func(client *opnsense.Client) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			_, err := client.FirewallFilterRuleAddCtx(ctx, &opnsense.FilterRule{Description: "allow ssh"})
+
+			return err
+		}
+	}
+
+	tests := []struct {
+		name       string
+		stage      func(client *opnsense.Client) func(ctx context.Context) error
+		check      opnsense.FirewallFilterCheck
+		err        error
+		applies    int
+		cancels    int
+		reverts    int
+		rulesAdded int
+	}{
+		{
+			name:       "success",
+			stage:      addRule,
+			applies:    1,
+			cancels:    1,
+			rulesAdded: 1,
+		},
+		{
+			name:  "check fails",
+			stage: addRule,
+			check: func(context.Context, *opnsense.Client) error {
+				return errLockedOut
+			},
+			err:        errLockedOut,
+			applies:    1,
+			reverts:    1,
+			rulesAdded: 1,
+		},
+		{
+			name: "stage fails",
+			stage: func(*opnsense.Client) func(ctx context.Context) error {
+				return func(context.Context) error {
+					return errLockedOut
+				}
+			},
+			err:     errLockedOut,
+			reverts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := opnsensetest.NewServer()
+			defer server.Close()
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("Failed to create client: %s", err)
+			}
+
+			err = client.FirewallFilterTransaction(tt.stage(client), tt.check)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected error %v, got %v", tt.err, err)
+			}
+
+			if tt.err != nil && !errors.Is(err, opnsense.ErrOpnsenseFilterRolledBack) {
+				t.Errorf("Expected changes to be rolled back, got %v", err)
+			}
+
+			counts := map[string][2]int{
+				"firewall/filter/savepoint":      {server.Count("firewall/filter/savepoint"), 1},
+				"firewall/filter/apply":          {server.Count("firewall/filter/apply"), tt.applies},
+				"firewall/filter/cancelRollback": {server.Count("firewall/filter/cancelRollback"), tt.cancels},
+				"firewall/filter/revert":         {server.Count("firewall/filter/revert"), tt.reverts},
+				"firewall/filter/addRule":        {server.Count("firewall/filter/addRule"), tt.rulesAdded},
+			}
+
+			for route, count := range counts {
+				if count[0] != count[1] {
+					t.Errorf("Expected %d requests to %s, got %d: %v", count[1], route, count[0], server.Requests())
+				}
+			}
+		})
+	}
+}
+
+func TestFirewallFilterTransactionRevertFails(t *testing.T) {
+	server := opnsensetest.NewServer()
+	defer server.Close()
+
+	client, err := server.Client(opnsense.WithRetryPolicy(opnsense.RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	err = client.FirewallFilterTransaction(
+		func(context.Context) error { return nil },
+		func(context.Context, *opnsense.Client) error {
+			// The rules locked the client out.
+			server.SetCredentials("", "")
+
+			return errLockedOut
+		},
+	)
+
+	if !errors.Is(err, errLockedOut) || !errors.Is(err, opnsense.ErrOpnsense401) {
+		t.Errorf("Expected check and revert errors, got %v", err)
+	}
+
+	if errors.Is(err, opnsense.ErrOpnsenseFilterRolledBack) {
+		t.Errorf("Expected failed revert not to be reported as rolled back")
+	}
+}
+
+func TestFirewallFilterTransactionCancelRollbackFails(t *testing.T) {
+	server := opnsensetest.NewServer()
+	defer server.Close()
+
+	server.Handle("firewall/filter/cancelRollback", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	client, err := server.Client(opnsense.WithRetryPolicy(opnsense.RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	err = client.FirewallFilterTransaction(func(context.Context) error { return nil }, nil)
+
+	if !errors.Is(err, opnsense.ErrOpnsenseFilterRollbackPending) || !errors.Is(err, opnsense.ErrOpnsense500) {
+		t.Errorf("Expected pending rollback and cancel errors, got %v", err)
+	}
+
+	if errors.Is(err, opnsense.ErrOpnsenseFilterRolledBack) {
+		t.Errorf("Expected pending rollback not to be reported as rolled back")
+	}
+}
@@ -1,16 +1,20 @@
 package opnsense
 
 import (
+	"context"
 	"fmt"
-	"log"
 )
 
 func (c *Client) PowerOff() (*StatusMessage, error) {
+	return c.PowerOffCtx(context.Background())
+}
+
+func (c *Client) PowerOffCtx(ctx context.Context) (*StatusMessage, error) {
 	api := "core/firmware/poweroff"
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -19,11 +23,15 @@ func (c *Client) PowerOff() (*StatusMessage, error) {
 }
 
 func (c *Client) Reboot() (*StatusMessage, error) {
+	return c.RebootCtx(context.Background())
+}
+
+func (c *Client) RebootCtx(ctx context.Context) (*StatusMessage, error) {
 	api := "core/firmware/reboot"
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -32,11 +40,15 @@ func (c *Client) Reboot() (*StatusMessage, error) {
 }
 
 func (c *Client) Upgrade() (*StatusMessage, error) {
+	return c.UpgradeCtx(context.Background())
+}
+
+func (c *Client) UpgradeCtx(ctx context.Context) (*StatusMessage, error) {
 	api := "core/firmware/upgrade"
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -50,11 +62,15 @@ type UpgradeStatusMessage struct {
 }
 
 func (c *Client) UpgradeStatus() (*UpgradeStatusMessage, error) {
+	return c.UpgradeStatusCtx(context.Background())
+}
+
+func (c *Client) UpgradeStatusCtx(ctx context.Context) (*UpgradeStatusMessage, error) {
 	api := "core/firmware/upgradestatus"
 
 	var status UpgradeStatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +79,15 @@ func (c *Client) UpgradeStatus() (*UpgradeStatusMessage, error) {
 }
 
 func (c *Client) Audit() (*StatusMessage, error) {
+	return c.AuditCtx(context.Background())
+}
+
+func (c *Client) AuditCtx(ctx context.Context) (*StatusMessage, error) {
 	api := "core/firmware/audit"
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -82,20 +102,28 @@ type FirmwareConfig struct {
 }
 
 func (c *Client) FirmwareConfigGet() (*FirmwareConfig, error) {
+	return c.FirmwareConfigGetCtx(context.Background())
+}
+
+func (c *Client) FirmwareConfigGetCtx(ctx context.Context) (*FirmwareConfig, error) {
 	api := "core/firmware/getfirmwareconfig"
 
 	var firmwareConfig FirmwareConfig
-	err := c.GetAndUnmarshal(api, &firmwareConfig)
+	err := c.GetAndUnmarshalCtx(ctx, api, &firmwareConfig)
 
 	return &firmwareConfig, err
 }
 
 func (c *Client) FirmwareConfigSet(config FirmwareConfig) (*StatusMessage, error) {
+	return c.FirmwareConfigSetCtx(context.Background(), config)
+}
+
+func (c *Client) FirmwareConfigSetCtx(ctx context.Context, config FirmwareConfig) (*StatusMessage, error) {
 	api := "core/firmware/setfirmwareconfig"
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, config, &status)
+	err := c.PostAndMarshalCtx(ctx, api, config, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -112,10 +140,14 @@ type FirmwareOptions struct {
 }
 
 func (c *Client) FirmwareOptionsGet() (*FirmwareOptions, error) {
+	return c.FirmwareOptionsGetCtx(context.Background())
+}
+
+func (c *Client) FirmwareOptionsGetCtx(ctx context.Context) (*FirmwareOptions, error) {
 	api := "core/firmware/getfirmwareoptions"
 
 	var firmwareOptions FirmwareOptions
-	err := c.GetAndUnmarshal(api, &firmwareOptions)
+	err := c.GetAndUnmarshalCtx(ctx, api, &firmwareOptions)
 
 	return &firmwareOptions, err
 }
@@ -143,10 +175,14 @@ type Status struct {
 }
 
 func (c *Client) FirmwareStatus() (*Status, error) {
+	return c.FirmwareStatusCtx(context.Background())
+}
+
+func (c *Client) FirmwareStatusCtx(ctx context.Context) (*Status, error) {
 	api := "core/firmware/status"
 
 	var status Status
-	err := c.GetAndUnmarshal(api, &status)
+	err := c.GetAndUnmarshalCtx(ctx, api, &status)
 
 	return &status, err
 }
@@ -157,10 +193,14 @@ type UpgradeStatus struct {
 }
 
 func (c *Client) FirmwareUpgradeStatus() (*UpgradeStatus, error) {
+	return c.FirmwareUpgradeStatusCtx(context.Background())
+}
+
+func (c *Client) FirmwareUpgradeStatusCtx(ctx context.Context) (*UpgradeStatus, error) {
 	api := "core/firmware/upgradestatus"
 
 	var status UpgradeStatus
-	err := c.GetAndUnmarshal(api, &status)
+	err := c.GetAndUnmarshalCtx(ctx, api, &status)
 
 	return &status, err
 }
@@ -180,10 +220,14 @@ type Information struct {
 }
 
 func (c *Client) FirmwareInformation() (*Information, error) {
+	return c.FirmwareInformationCtx(context.Background())
+}
+
+func (c *Client) FirmwareInformationCtx(ctx context.Context) (*Information, error) {
 	api := "core/firmware/info"
 
 	var information Information
-	err := c.GetAndUnmarshal(api, &information)
+	err := c.GetAndUnmarshalCtx(ctx, api, &information)
 
 	return &information, err
 }
@@ -204,7 +248,11 @@ type Package struct {
 }
 
 func (c *Client) FirmwareInstalledPluginsList() ([]Package, error) {
-	info, err := c.FirmwareInformation()
+	return c.FirmwareInstalledPluginsListCtx(context.Background())
+}
+
+func (c *Client) FirmwareInstalledPluginsListCtx(ctx context.Context) ([]Package, error) {
+	info, err := c.FirmwareInformationCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -221,17 +269,21 @@ func (c *Client) FirmwareInstalledPluginsList() ([]Package, error) {
 }
 
 func (c *Client) FirmwareInstall(packageName string) error {
+	return c.FirmwareInstallCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareInstallCtx(ctx context.Context, packageName string) error {
 	api := "core/firmware/install/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return err
 	}
 
 	if status.Status != StatusOK {
-		log.Printf("[TRACE] FirmwareInstall response: %#v", status)
+		c.logger.DebugContext(ctx, "FirmwareInstall response", "response", status)
 
 		return fmt.Errorf("FirmwareInstall failed: %w", ErrOpnsenseStatusNotOk)
 	}
@@ -240,17 +292,21 @@ func (c *Client) FirmwareInstall(packageName string) error {
 }
 
 func (c *Client) FirmwareReInstall(packageName string) error {
+	return c.FirmwareReInstallCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareReInstallCtx(ctx context.Context, packageName string) error {
 	api := "core/firmware/reinstall/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return err
 	}
 
 	if status.Status != StatusOK {
-		log.Printf("[TRACE] FirmwareReInstall response: %#v", status)
+		c.logger.DebugContext(ctx, "FirmwareReInstall response", "response", status)
 
 		return fmt.Errorf("FirmwareReInstall failed: %w", ErrOpnsenseStatusNotOk)
 	}
@@ -259,17 +315,21 @@ func (c *Client) FirmwareReInstall(packageName string) error {
 }
 
 func (c *Client) FirmwareRemove(packageName string) error {
+	return c.FirmwareRemoveCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareRemoveCtx(ctx context.Context, packageName string) error {
 	api := "core/firmware/remove/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return err
 	}
 
 	if status.Status != StatusOK {
-		log.Printf("[TRACE] FirmwareRemove response: %#v", status)
+		c.logger.DebugContext(ctx, "FirmwareRemove response", "response", status)
 
 		return fmt.Errorf("FirmwareRemove failed: %w", ErrOpnsenseStatusNotOk)
 	}
@@ -278,17 +338,21 @@ func (c *Client) FirmwareRemove(packageName string) error {
 }
 
 func (c *Client) FirmwareLock(packageName string) error {
+	return c.FirmwareLockCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareLockCtx(ctx context.Context, packageName string) error {
 	api := "core/firmware/lock/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return err
 	}
 
 	if status.Status != StatusOK {
-		log.Printf("[TRACE] FirmwareLock response: %#v", status)
+		c.logger.DebugContext(ctx, "FirmwareLock response", "response", status)
 
 		return fmt.Errorf("FirmwareLock failed: %w", ErrOpnsenseStatusNotOk)
 	}
@@ -297,17 +361,21 @@ func (c *Client) FirmwareLock(packageName string) error {
 }
 
 func (c *Client) FirmwareUnlock(packageName string) error {
+	return c.FirmwareUnlockCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareUnlockCtx(ctx context.Context, packageName string) error {
 	api := "core/firmware/unlock/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return err
 	}
 
 	if status.Status != StatusOK {
-		log.Printf("[TRACE] FirmwareUnlock response: %#v", status)
+		c.logger.DebugContext(ctx, "FirmwareUnlock response", "response", status)
 
 		return fmt.Errorf("FirmwareUnlock failed: %w", ErrOpnsenseStatusNotOk)
 	}
@@ -316,11 +384,15 @@ func (c *Client) FirmwareUnlock(packageName string) error {
 }
 
 func (c *Client) FirmwareDetails(packageName string) (*StatusMessage, error) {
+	return c.FirmwareDetailsCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareDetailsCtx(ctx context.Context, packageName string) (*StatusMessage, error) {
 	api := "core/firmware/details/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -329,11 +401,15 @@ func (c *Client) FirmwareDetails(packageName string) (*StatusMessage, error) {
 }
 
 func (c *Client) FirmwareLicense(packageName string) (*StatusMessage, error) {
+	return c.FirmwareLicenseCtx(context.Background(), packageName)
+}
+
+func (c *Client) FirmwareLicenseCtx(ctx context.Context, packageName string) (*StatusMessage, error) {
 	api := "core/firmware/license/" + packageName
 
 	var status StatusMessage
 
-	err := c.PostAndMarshal(api, nil, &status)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &status)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+package opnsense_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+	"github.com/kradalby/opnsense-go/opnsense/opnsensetest"
+	uuid "github.com/satori/go.uuid"
+)
+
+// The fixture holds the same neighbor as returned by releases encoding
+// selected as an int and by those encoding it as a bool.
+func TestFixtureSelectedEncoding(t *testing.T) {
+	recorder, err := opnsensetest.NewRecorder(
+		filepath.Join("testdata", "fixtures", "bgp_neighbor_selected.json"),
+		opnsensetest.Replay,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Failed to load fixture: %s", err)
+	}
+
+	client, err := opnsense.New(opnsense.WithBaseURL("https://firewall.invalid"), recorder.Option())
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	id := uuid.FromStringOrNil("5a6b2a3e-0f4c-4e43-9b43-4c1e4c1f3d21")
+
+	for _, encoding := range []string{"int", "bool"} {
+		neighbor, err := client.BgpNeighborGet(id)
+		if err != nil {
+			t.Fatalf("Failed to get neighbor with selected as %s: %s", encoding, err)
+		}
+
+		selected := opnsense.ListSelectedKeys(neighbor.Updatesource)
+		if len(selected) != 1 || selected[0] != "lan" || neighbor.Updatesource["lan"].Value != "LAN" {
+			t.Errorf("Unexpected update source with selected as %s: %v", encoding, neighbor.Updatesource)
+		}
+	}
+}
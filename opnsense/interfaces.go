@@ -0,0 +1,235 @@
+package opnsense
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// WithInterfaceValidation makes the client check that the interfaces of
+// filter and NAT rules exist before they are added or set, returning
+// ErrOpnsenseUnknownInterface instead of leaving the rule to OPNsense, which
+// accepts names it does not know. Every check lists the interfaces and
+// interface groups, costing two requests per rule.
+func WithInterfaceValidation() Option {
+	return func(cfg *clientConfig) error {
+		cfg.validateInterfaces = true
+
+		return nil
+	}
+}
+
+// InterfaceAddress is an address assigned to an interface in CIDR notation,
+// e.g. 192.168.1.1/24.
+type InterfaceAddress struct {
+	Address string `json:"ipaddr"`
+}
+
+// InterfaceInfo describes an interface as shown on the interface overview.
+type InterfaceInfo struct {
+	// Identifier is the name rules refer to the interface by, e.g. lan or
+	// opt1.
+	Identifier  Interface          `json:"identifier"`
+	Device      string             `json:"device"`
+	Description string             `json:"description"`
+	Enabled     bool               `json:"enabled"`
+	Status      string             `json:"status"`
+	MACAddress  string             `json:"macaddr"`
+	IPv4        []InterfaceAddress `json:"ipv4"`
+	IPv6        []InterfaceAddress `json:"ipv6"`
+}
+
+func (c *Client) InterfacesList() ([]*InterfaceInfo, error) {
+	return c.InterfacesListCtx(context.Background())
+}
+
+// InterfacesListCtx returns the assigned interfaces. Unassigned devices are
+// not included.
+func (c *Client) InterfacesListCtx(ctx context.Context) ([]*InterfaceInfo, error) {
+	interfaces := []*InterfaceInfo{}
+
+	for info, err := range SearchAll[*InterfaceInfo](ctx, c, "interfaces/overview/interfacesInfo", SearchOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Identifier != "" {
+			interfaces = append(interfaces, info)
+		}
+	}
+
+	return interfaces, nil
+}
+
+func (c *Client) InterfacesValidate(iface Interface) error {
+	return c.InterfacesValidateCtx(context.Background(), iface)
+}
+
+// InterfacesValidateCtx checks that every interface in iface is an assigned
+// interface or an interface group. An empty iface is valid, it is used by
+// floating rules.
+func (c *Client) InterfacesValidateCtx(ctx context.Context, iface Interface) error {
+	if iface == "" {
+		return nil
+	}
+
+	interfaces, err := c.InterfacesListCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	groups, err := c.InterfaceGroupSearchCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := map[string]bool{}
+
+	for _, info := range interfaces {
+		known[string(info.Identifier)] = true
+	}
+
+	for _, group := range groups {
+		known[group.Name] = true
+	}
+
+	for _, name := range strings.Split(string(iface), ",") {
+		if !known[strings.TrimSpace(name)] {
+			return fmt.Errorf("%w: %q", ErrOpnsenseUnknownInterface, name)
+		}
+	}
+
+	return nil
+}
+
+// checkInterfaces validates iface if the client was created with
+// WithInterfaceValidation.
+func (c *Client) checkInterfaces(ctx context.Context, iface Interface) error {
+	if !c.validateInterfaces {
+		return nil
+	}
+
+	return c.InterfacesValidateCtx(ctx, iface)
+}
+
+// InterfaceGroup bundles interfaces so rules can refer to all of them by the
+// name of the group.
+type InterfaceGroup struct {
+	UUID *uuid.UUID `json:"uuid,omitempty"`
+	Name string     `json:"ifname"`
+	// Members are the interfaces in the group, e.g. lan,opt1.
+	Members Interface `json:"members"`
+	// NoGroup hides the group from the interface menu.
+	NoGroup     Bool    `json:"nogroup"`
+	Sequence    Integer `json:"sequence,omitempty"`
+	Description string  `json:"descr"`
+}
+
+func (g *InterfaceGroup) setUUID(id uuid.UUID) {
+	g.UUID = &id
+}
+
+func (c *Client) interfaceGroupResource() *Resource[InterfaceGroup, InterfaceGroup] {
+	return NewResource[InterfaceGroup, InterfaceGroup](c, ResourceConfig{
+		Module:      "firewall/group",
+		Key:         "group",
+		Suffix:      "Item",
+		Reconfigure: "firewall/group/reconfigure",
+	})
+}
+
+func (c *Client) InterfaceGroupGet(uuid uuid.UUID) (*InterfaceGroup, error) {
+	return c.InterfaceGroupGetCtx(context.Background(), uuid)
+}
+
+func (c *Client) InterfaceGroupGetCtx(ctx context.Context, uuid uuid.UUID) (*InterfaceGroup, error) {
+	return c.interfaceGroupResource().Get(ctx, uuid)
+}
+
+func (c *Client) InterfaceGroupSearch() ([]*InterfaceGroup, error) {
+	return c.InterfaceGroupSearchCtx(context.Background())
+}
+
+func (c *Client) InterfaceGroupSearchCtx(ctx context.Context) ([]*InterfaceGroup, error) {
+	return collect(c.InterfaceGroupSearchAll(ctx, SearchOptions{}))
+}
+
+// InterfaceGroupSearchAll iterates over the interface groups matching opts,
+// fetching pages as they are needed.
+func (c *Client) InterfaceGroupSearchAll(ctx context.Context, opts SearchOptions) iter.Seq2[*InterfaceGroup, error] {
+	return SearchAll[*InterfaceGroup](ctx, c, c.interfaceGroupResource().searchEndpoint(), opts)
+}
+
+func (c *Client) InterfaceGroupAdd(group *InterfaceGroup) (*uuid.UUID, error) {
+	return c.InterfaceGroupAddCtx(context.Background(), group)
+}
+
+// InterfaceGroupAddCtx adds group and sets group.UUID to the UUID OPNsense
+// assigned to it. The group is created on the firewall by
+// InterfaceGroupReconfigure.
+func (c *Client) InterfaceGroupAddCtx(ctx context.Context, group *InterfaceGroup) (*uuid.UUID, error) {
+	err := c.checkInterfaces(ctx, group.Members)
+	if err != nil {
+		return nil, fmt.Errorf("InterfaceGroupAdd failed: %w", err)
+	}
+
+	response, err := c.interfaceGroupResource().Add(ctx, *group)
+	if err != nil {
+		return nil, fmt.Errorf("InterfaceGroupAdd failed: %w", err)
+	}
+
+	group.UUID = response.UUID
+
+	return response.UUID, nil
+}
+
+func (c *Client) InterfaceGroupSet(group *InterfaceGroup) error {
+	return c.InterfaceGroupSetCtx(context.Background(), group)
+}
+
+func (c *Client) InterfaceGroupSetCtx(ctx context.Context, group *InterfaceGroup) error {
+	if group.UUID == nil {
+		return fmt.Errorf("InterfaceGroupSet failed: %w", ErrOpnsenseMissingUUID)
+	}
+
+	err := c.checkInterfaces(ctx, group.Members)
+	if err != nil {
+		return fmt.Errorf("InterfaceGroupSet failed: %w", err)
+	}
+
+	_, err = c.interfaceGroupResource().Set(ctx, *group.UUID, *group)
+	if err != nil {
+		return fmt.Errorf("InterfaceGroupSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) InterfaceGroupDelete(uuid uuid.UUID) error {
+	return c.InterfaceGroupDeleteCtx(context.Background(), uuid)
+}
+
+func (c *Client) InterfaceGroupDeleteCtx(ctx context.Context, uuid uuid.UUID) error {
+	_, err := c.interfaceGroupResource().Delete(ctx, uuid)
+	if err != nil {
+		return fmt.Errorf("InterfaceGroupDelete failed: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) InterfaceGroupReconfigure() error {
+	return c.InterfaceGroupReconfigureCtx(context.Background())
+}
+
+func (c *Client) InterfaceGroupReconfigureCtx(ctx context.Context) error {
+	err := c.interfaceGroupResource().Reconfigure(ctx)
+	if err != nil {
+		return fmt.Errorf("InterfaceGroupReconfigure failed: %w", err)
+	}
+
+	return nil
+}
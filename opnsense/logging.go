@@ -0,0 +1,151 @@
+package opnsense
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// The client logs every request and response at debug level and failures at
+// error level. Nothing is logged unless a logger is given with WithLogger,
+// and that logger is always wrapped in a handler which redacts credentials,
+// private keys and configuration backups before they reach the caller's
+// handler.
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func newRedactingLogger(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return discardLogger()
+	}
+
+	return slog.New(&redactingHandler{next: logger.Handler()})
+}
+
+type redactingHandler struct {
+	next slog.Handler
+}
+
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	clean := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+
+	record.Attrs(func(attr slog.Attr) bool {
+		clean.AddAttrs(redactAttr(attr))
+
+		return true
+	})
+
+	return h.next.Handle(ctx, clean)
+}
+
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clean := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		clean = append(clean, redactAttr(attr))
+	}
+
+	return &redactingHandler{next: h.next.WithAttrs(clean)}
+}
+
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(attr slog.Attr) slog.Attr {
+	if isSecretKey(attr.Key) {
+		return slog.String(attr.Key, redacted)
+	}
+
+	value := attr.Value.Resolve()
+
+	switch value.Kind() { //nolint:exhaustive // Only groups and strings can carry secrets.
+	case slog.KindGroup:
+		group := value.Group()
+		clean := make([]interface{}, 0, len(group))
+
+		for _, a := range group {
+			clean = append(clean, redactAttr(a))
+		}
+
+		return slog.Group(attr.Key, clean...)
+	case slog.KindString:
+		if attr.Key == "body" {
+			return slog.String(attr.Key, string(redactBody([]byte(value.String()))))
+		}
+	}
+
+	return attr
+}
+
+func isSecretKey(key string) bool {
+	switch strings.ToLower(key) {
+	case "key", "secret", "password", "privkey", "psk", "apikey", "apisecret", "authorization":
+		return true
+	}
+
+	return false
+}
+
+// RedactBody returns body the way the client logs it, with the values of
+// secret fields in JSON documents replaced and configuration backups dropped
+// entirely.
+func RedactBody(body []byte) []byte {
+	return redactBody(body)
+}
+
+// redactBody replaces the values of secret fields in a JSON document. Backups
+// are the complete config.xml of the firewall and are dropped entirely.
+func redactBody(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+
+	if bytes.HasPrefix(trimmed, []byte("<?xml")) || bytes.HasPrefix(trimmed, []byte("<opnsense")) {
+		return []byte(redacted)
+	}
+
+	var document interface{}
+
+	if err := json.Unmarshal(trimmed, &document); err != nil {
+		return body
+	}
+
+	clean, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return []byte(redacted)
+	}
+
+	return clean
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSecretKey(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
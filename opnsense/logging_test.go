@@ -0,0 +1,33 @@
+package opnsense
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedactingLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := newRedactingLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	logger.Debug(
+		"received response",
+		"secret", "supersecret",
+		"body", `{"server":{"name":"wg0","privkey":"cHJpdmF0ZWtleQ==","peers":[{"psk":"c2hhcmVk"}]}}`,
+	)
+	logger.With("key", "apikey").Debug("backup", "body", `<?xml version="1.0"?><opnsense></opnsense>`)
+
+	output := buf.String()
+
+	for _, secret := range []string{"supersecret", "cHJpdmF0ZWtleQ==", "c2hhcmVk", "apikey", "<opnsense>"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("Expected %s to be redacted from log output: %s", secret, output)
+		}
+	}
+
+	if !strings.Contains(output, "wg0") {
+		t.Errorf("Expected non-secret fields to be kept in log output: %s", output)
+	}
+}
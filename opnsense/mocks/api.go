@@ -0,0 +1,7272 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api.go
+//
+// Generated by this command:
+//
+//	mockgen -source=api.go -destination=mocks/api.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+	reflect "reflect"
+
+	opnsense "github.com/kradalby/opnsense-go/opnsense"
+	uuid "github.com/satori/go.uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAliasAPI is a mock of AliasAPI interface.
+type MockAliasAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAliasAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockAliasAPIMockRecorder is the mock recorder for MockAliasAPI.
+type MockAliasAPIMockRecorder struct {
+	mock *MockAliasAPI
+}
+
+// NewMockAliasAPI creates a new mock instance.
+func NewMockAliasAPI(ctrl *gomock.Controller) *MockAliasAPI {
+	mock := &MockAliasAPI{ctrl: ctrl}
+	mock.recorder = &MockAliasAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAliasAPI) EXPECT() *MockAliasAPIMockRecorder {
+	return m.recorder
+}
+
+// AliasAdd mocks base method.
+func (m *MockAliasAPI) AliasAdd(conf opnsense.AliasFormat) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasAdd", conf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasAdd indicates an expected call of AliasAdd.
+func (mr *MockAliasAPIMockRecorder) AliasAdd(conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasAdd", reflect.TypeOf((*MockAliasAPI)(nil).AliasAdd), conf)
+}
+
+// AliasAddCtx mocks base method.
+func (m *MockAliasAPI) AliasAddCtx(ctx context.Context, conf opnsense.AliasFormat) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasAddCtx", ctx, conf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasAddCtx indicates an expected call of AliasAddCtx.
+func (mr *MockAliasAPIMockRecorder) AliasAddCtx(ctx, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasAddCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasAddCtx), ctx, conf)
+}
+
+// AliasDelete mocks base method.
+func (m *MockAliasAPI) AliasDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasDelete indicates an expected call of AliasDelete.
+func (mr *MockAliasAPIMockRecorder) AliasDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasDelete", reflect.TypeOf((*MockAliasAPI)(nil).AliasDelete), arg0)
+}
+
+// AliasDeleteCtx mocks base method.
+func (m *MockAliasAPI) AliasDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasDeleteCtx indicates an expected call of AliasDeleteCtx.
+func (mr *MockAliasAPIMockRecorder) AliasDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasDeleteCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasDeleteCtx), ctx, arg1)
+}
+
+// AliasGet mocks base method.
+func (m *MockAliasAPI) AliasGet(arg0 uuid.UUID) (*opnsense.AliasFormat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGet", arg0)
+	ret0, _ := ret[0].(*opnsense.AliasFormat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGet indicates an expected call of AliasGet.
+func (mr *MockAliasAPIMockRecorder) AliasGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGet", reflect.TypeOf((*MockAliasAPI)(nil).AliasGet), arg0)
+}
+
+// AliasGetCtx mocks base method.
+func (m *MockAliasAPI) AliasGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.AliasFormat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.AliasFormat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGetCtx indicates an expected call of AliasGetCtx.
+func (mr *MockAliasAPIMockRecorder) AliasGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGetCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasGetCtx), ctx, arg1)
+}
+
+// AliasGetList mocks base method.
+func (m *MockAliasAPI) AliasGetList() (*opnsense.AliasList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGetList")
+	ret0, _ := ret[0].(*opnsense.AliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGetList indicates an expected call of AliasGetList.
+func (mr *MockAliasAPIMockRecorder) AliasGetList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGetList", reflect.TypeOf((*MockAliasAPI)(nil).AliasGetList))
+}
+
+// AliasGetListCtx mocks base method.
+func (m *MockAliasAPI) AliasGetListCtx(ctx context.Context) (*opnsense.AliasList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGetListCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.AliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGetListCtx indicates an expected call of AliasGetListCtx.
+func (mr *MockAliasAPIMockRecorder) AliasGetListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGetListCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasGetListCtx), ctx)
+}
+
+// AliasReconfigure mocks base method.
+func (m *MockAliasAPI) AliasReconfigure() (*opnsense.AliasReconfigureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasReconfigure")
+	ret0, _ := ret[0].(*opnsense.AliasReconfigureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasReconfigure indicates an expected call of AliasReconfigure.
+func (mr *MockAliasAPIMockRecorder) AliasReconfigure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasReconfigure", reflect.TypeOf((*MockAliasAPI)(nil).AliasReconfigure))
+}
+
+// AliasReconfigureCtx mocks base method.
+func (m *MockAliasAPI) AliasReconfigureCtx(ctx context.Context) (*opnsense.AliasReconfigureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasReconfigureCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.AliasReconfigureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasReconfigureCtx indicates an expected call of AliasReconfigureCtx.
+func (mr *MockAliasAPIMockRecorder) AliasReconfigureCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasReconfigureCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasReconfigureCtx), ctx)
+}
+
+// AliasSearchAll mocks base method.
+func (m *MockAliasAPI) AliasSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[opnsense.AliasListItem, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[opnsense.AliasListItem, error])
+	return ret0
+}
+
+// AliasSearchAll indicates an expected call of AliasSearchAll.
+func (mr *MockAliasAPIMockRecorder) AliasSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasSearchAll", reflect.TypeOf((*MockAliasAPI)(nil).AliasSearchAll), ctx, opts)
+}
+
+// AliasUpdate mocks base method.
+func (m *MockAliasAPI) AliasUpdate(arg0 uuid.UUID, conf opnsense.AliasFormat) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUpdate", arg0, conf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUpdate indicates an expected call of AliasUpdate.
+func (mr *MockAliasAPIMockRecorder) AliasUpdate(arg0, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUpdate", reflect.TypeOf((*MockAliasAPI)(nil).AliasUpdate), arg0, conf)
+}
+
+// AliasUpdateCtx mocks base method.
+func (m *MockAliasAPI) AliasUpdateCtx(ctx context.Context, arg1 uuid.UUID, conf opnsense.AliasFormat) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUpdateCtx", ctx, arg1, conf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUpdateCtx indicates an expected call of AliasUpdateCtx.
+func (mr *MockAliasAPIMockRecorder) AliasUpdateCtx(ctx, arg1, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUpdateCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasUpdateCtx), ctx, arg1, conf)
+}
+
+// AliasUtilsAdd mocks base method.
+func (m *MockAliasAPI) AliasUtilsAdd(name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsAdd", name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsAdd indicates an expected call of AliasUtilsAdd.
+func (mr *MockAliasAPIMockRecorder) AliasUtilsAdd(name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsAdd", reflect.TypeOf((*MockAliasAPI)(nil).AliasUtilsAdd), name, request)
+}
+
+// AliasUtilsAddCtx mocks base method.
+func (m *MockAliasAPI) AliasUtilsAddCtx(ctx context.Context, name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsAddCtx", ctx, name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsAddCtx indicates an expected call of AliasUtilsAddCtx.
+func (mr *MockAliasAPIMockRecorder) AliasUtilsAddCtx(ctx, name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsAddCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasUtilsAddCtx), ctx, name, request)
+}
+
+// AliasUtilsDel mocks base method.
+func (m *MockAliasAPI) AliasUtilsDel(name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsDel", name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsDel indicates an expected call of AliasUtilsDel.
+func (mr *MockAliasAPIMockRecorder) AliasUtilsDel(name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsDel", reflect.TypeOf((*MockAliasAPI)(nil).AliasUtilsDel), name, request)
+}
+
+// AliasUtilsDelCtx mocks base method.
+func (m *MockAliasAPI) AliasUtilsDelCtx(ctx context.Context, name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsDelCtx", ctx, name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsDelCtx indicates an expected call of AliasUtilsDelCtx.
+func (mr *MockAliasAPIMockRecorder) AliasUtilsDelCtx(ctx, name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsDelCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasUtilsDelCtx), ctx, name, request)
+}
+
+// AliasUtilsGet mocks base method.
+func (m *MockAliasAPI) AliasUtilsGet(name string) (*opnsense.AliasUtilsGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsGet", name)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsGet indicates an expected call of AliasUtilsGet.
+func (mr *MockAliasAPIMockRecorder) AliasUtilsGet(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsGet", reflect.TypeOf((*MockAliasAPI)(nil).AliasUtilsGet), name)
+}
+
+// AliasUtilsGetCtx mocks base method.
+func (m *MockAliasAPI) AliasUtilsGetCtx(ctx context.Context, name string) (*opnsense.AliasUtilsGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsGetCtx", ctx, name)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsGetCtx indicates an expected call of AliasUtilsGetCtx.
+func (mr *MockAliasAPIMockRecorder) AliasUtilsGetCtx(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsGetCtx", reflect.TypeOf((*MockAliasAPI)(nil).AliasUtilsGetCtx), ctx, name)
+}
+
+// MockFirewallFilterAPI is a mock of FirewallFilterAPI interface.
+type MockFirewallFilterAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallFilterAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallFilterAPIMockRecorder is the mock recorder for MockFirewallFilterAPI.
+type MockFirewallFilterAPIMockRecorder struct {
+	mock *MockFirewallFilterAPI
+}
+
+// NewMockFirewallFilterAPI creates a new mock instance.
+func NewMockFirewallFilterAPI(ctrl *gomock.Controller) *MockFirewallFilterAPI {
+	mock := &MockFirewallFilterAPI{ctrl: ctrl}
+	mock.recorder = &MockFirewallFilterAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallFilterAPI) EXPECT() *MockFirewallFilterAPIMockRecorder {
+	return m.recorder
+}
+
+// FirewallFilterApply mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterApply(rollbackRevision *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterApply", rollbackRevision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterApply indicates an expected call of FirewallFilterApply.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterApply(rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterApply", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterApply), rollbackRevision)
+}
+
+// FirewallFilterApplyCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterApplyCtx(ctx context.Context, rollbackRevision *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterApplyCtx", ctx, rollbackRevision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterApplyCtx indicates an expected call of FirewallFilterApplyCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterApplyCtx(ctx, rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterApplyCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterApplyCtx), ctx, rollbackRevision)
+}
+
+// FirewallFilterCancelRollback mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterCancelRollback(rollbackRevision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterCancelRollback", rollbackRevision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterCancelRollback indicates an expected call of FirewallFilterCancelRollback.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterCancelRollback(rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterCancelRollback", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterCancelRollback), rollbackRevision)
+}
+
+// FirewallFilterCancelRollbackCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterCancelRollbackCtx(ctx context.Context, rollbackRevision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterCancelRollbackCtx", ctx, rollbackRevision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterCancelRollbackCtx indicates an expected call of FirewallFilterCancelRollbackCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterCancelRollbackCtx(ctx, rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterCancelRollbackCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterCancelRollbackCtx), ctx, rollbackRevision)
+}
+
+// FirewallFilterRevert mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRevert(revision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRevert", revision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRevert indicates an expected call of FirewallFilterRevert.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRevert(revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRevert", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRevert), revision)
+}
+
+// FirewallFilterRevertCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRevertCtx(ctx context.Context, revision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRevertCtx", ctx, revision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRevertCtx indicates an expected call of FirewallFilterRevertCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRevertCtx(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRevertCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRevertCtx), ctx, revision)
+}
+
+// FirewallFilterRuleAdd mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleAdd(rule *opnsense.FilterRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleAdd indicates an expected call of FirewallFilterRuleAdd.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleAdd", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleAdd), rule)
+}
+
+// FirewallFilterRuleAddCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleAddCtx(ctx context.Context, rule *opnsense.FilterRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleAddCtx indicates an expected call of FirewallFilterRuleAddCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleAddCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleAddCtx), ctx, rule)
+}
+
+// FirewallFilterRuleDelete mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleDelete indicates an expected call of FirewallFilterRuleDelete.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDelete", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleDelete), arg0)
+}
+
+// FirewallFilterRuleDeleteByCategory mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleDeleteByCategory(category uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDeleteByCategory", category)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleDeleteByCategory indicates an expected call of FirewallFilterRuleDeleteByCategory.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleDeleteByCategory(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDeleteByCategory", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleDeleteByCategory), category)
+}
+
+// FirewallFilterRuleDeleteByCategoryCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleDeleteByCategoryCtx(ctx context.Context, category uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDeleteByCategoryCtx", ctx, category)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleDeleteByCategoryCtx indicates an expected call of FirewallFilterRuleDeleteByCategoryCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleDeleteByCategoryCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDeleteByCategoryCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleDeleteByCategoryCtx), ctx, category)
+}
+
+// FirewallFilterRuleDeleteCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleDeleteCtx indicates an expected call of FirewallFilterRuleDeleteCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDeleteCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallFilterRuleFindByCategory mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleFindByCategory(category uuid.UUID) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByCategory", category)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByCategory indicates an expected call of FirewallFilterRuleFindByCategory.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleFindByCategory(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByCategory", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleFindByCategory), category)
+}
+
+// FirewallFilterRuleFindByCategoryCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleFindByCategoryCtx(ctx context.Context, category uuid.UUID) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByCategoryCtx", ctx, category)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByCategoryCtx indicates an expected call of FirewallFilterRuleFindByCategoryCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleFindByCategoryCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByCategoryCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleFindByCategoryCtx), ctx, category)
+}
+
+// FirewallFilterRuleFindByDescription mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleFindByDescription(description string) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByDescription", description)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByDescription indicates an expected call of FirewallFilterRuleFindByDescription.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleFindByDescription(description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByDescription", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleFindByDescription), description)
+}
+
+// FirewallFilterRuleFindByDescriptionCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleFindByDescriptionCtx(ctx context.Context, description string) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByDescriptionCtx", ctx, description)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByDescriptionCtx indicates an expected call of FirewallFilterRuleFindByDescriptionCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleFindByDescriptionCtx(ctx, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByDescriptionCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleFindByDescriptionCtx), ctx, description)
+}
+
+// FirewallFilterRuleGet mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleGet(arg0 uuid.UUID) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleGet indicates an expected call of FirewallFilterRuleGet.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleGet", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleGet), arg0)
+}
+
+// FirewallFilterRuleGetCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleGetCtx indicates an expected call of FirewallFilterRuleGetCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleGetCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleGetCtx), ctx, arg1)
+}
+
+// FirewallFilterRuleMoveBefore mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleMoveBefore(id, anchor uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleMoveBefore", id, anchor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleMoveBefore indicates an expected call of FirewallFilterRuleMoveBefore.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleMoveBefore(id, anchor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleMoveBefore", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleMoveBefore), id, anchor)
+}
+
+// FirewallFilterRuleMoveBeforeCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleMoveBeforeCtx(ctx context.Context, id, anchor uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleMoveBeforeCtx", ctx, id, anchor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleMoveBeforeCtx indicates an expected call of FirewallFilterRuleMoveBeforeCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleMoveBeforeCtx(ctx, id, anchor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleMoveBeforeCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleMoveBeforeCtx), ctx, id, anchor)
+}
+
+// FirewallFilterRulePlan mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRulePlan(scope opnsense.FilterRuleScope, desired []opnsense.FilterRule) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlan", scope, desired)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRulePlan indicates an expected call of FirewallFilterRulePlan.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRulePlan(scope, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlan", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRulePlan), scope, desired)
+}
+
+// FirewallFilterRulePlanApply mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRulePlanApply(plan *opnsense.FilterRulePlan, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlanApply", plan, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulePlanApply indicates an expected call of FirewallFilterRulePlanApply.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRulePlanApply(plan, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlanApply", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRulePlanApply), plan, check)
+}
+
+// FirewallFilterRulePlanApplyCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRulePlanApplyCtx(ctx context.Context, plan *opnsense.FilterRulePlan, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlanApplyCtx", ctx, plan, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulePlanApplyCtx indicates an expected call of FirewallFilterRulePlanApplyCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRulePlanApplyCtx(ctx, plan, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlanApplyCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRulePlanApplyCtx), ctx, plan, check)
+}
+
+// FirewallFilterRulePlanCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRulePlanCtx(ctx context.Context, scope opnsense.FilterRuleScope, desired []opnsense.FilterRule) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlanCtx", ctx, scope, desired)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRulePlanCtx indicates an expected call of FirewallFilterRulePlanCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRulePlanCtx(ctx, scope, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlanCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRulePlanCtx), ctx, scope, desired)
+}
+
+// FirewallFilterRuleReconcile mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleReconcile(scope opnsense.FilterRuleScope, desired []opnsense.FilterRule, check opnsense.FirewallFilterCheck) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleReconcile", scope, desired, check)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleReconcile indicates an expected call of FirewallFilterRuleReconcile.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleReconcile(scope, desired, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleReconcile", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleReconcile), scope, desired, check)
+}
+
+// FirewallFilterRuleReconcileCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleReconcileCtx(ctx context.Context, scope opnsense.FilterRuleScope, desired []opnsense.FilterRule, check opnsense.FirewallFilterCheck) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleReconcileCtx", ctx, scope, desired, check)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleReconcileCtx indicates an expected call of FirewallFilterRuleReconcileCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleReconcileCtx(ctx, scope, desired, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleReconcileCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleReconcileCtx), ctx, scope, desired, check)
+}
+
+// FirewallFilterRuleSearch mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleSearch() ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleSearch indicates an expected call of FirewallFilterRuleSearch.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSearch", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleSearch))
+}
+
+// FirewallFilterRuleSearchAll mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.FilterRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.FilterRule, error])
+	return ret0
+}
+
+// FirewallFilterRuleSearchAll indicates an expected call of FirewallFilterRuleSearchAll.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSearchAll", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleSearchAll), ctx, opts)
+}
+
+// FirewallFilterRuleSearchCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleSearchCtx(ctx context.Context) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleSearchCtx indicates an expected call of FirewallFilterRuleSearchCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSearchCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleSearchCtx), ctx)
+}
+
+// FirewallFilterRuleSet mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleSet(rule *opnsense.FilterRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleSet indicates an expected call of FirewallFilterRuleSet.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSet", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleSet), rule)
+}
+
+// FirewallFilterRuleSetCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleSetCtx(ctx context.Context, rule *opnsense.FilterRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleSetCtx indicates an expected call of FirewallFilterRuleSetCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSetCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleSetCtx), ctx, rule)
+}
+
+// FirewallFilterRuleToggle mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleToggle indicates an expected call of FirewallFilterRuleToggle.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleToggle", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleToggle), arg0, enabled)
+}
+
+// FirewallFilterRuleToggleCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleToggleCtx indicates an expected call of FirewallFilterRuleToggleCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleToggleCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallFilterRulesReorder mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRulesReorder(order []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulesReorder", order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulesReorder indicates an expected call of FirewallFilterRulesReorder.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRulesReorder(order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulesReorder", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRulesReorder), order)
+}
+
+// FirewallFilterRulesReorderCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterRulesReorderCtx(ctx context.Context, order []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulesReorderCtx", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulesReorderCtx indicates an expected call of FirewallFilterRulesReorderCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterRulesReorderCtx(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulesReorderCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterRulesReorderCtx), ctx, order)
+}
+
+// FirewallFilterSavepoint mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterSavepoint() (*opnsense.Savepoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterSavepoint")
+	ret0, _ := ret[0].(*opnsense.Savepoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterSavepoint indicates an expected call of FirewallFilterSavepoint.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterSavepoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterSavepoint", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterSavepoint))
+}
+
+// FirewallFilterSavepointCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterSavepointCtx(ctx context.Context) (*opnsense.Savepoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterSavepointCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.Savepoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterSavepointCtx indicates an expected call of FirewallFilterSavepointCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterSavepointCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterSavepointCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterSavepointCtx), ctx)
+}
+
+// FirewallFilterTransaction mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterTransaction(stage func(context.Context) error, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterTransaction", stage, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterTransaction indicates an expected call of FirewallFilterTransaction.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterTransaction(stage, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterTransaction", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterTransaction), stage, check)
+}
+
+// FirewallFilterTransactionCtx mocks base method.
+func (m *MockFirewallFilterAPI) FirewallFilterTransactionCtx(ctx context.Context, stage func(context.Context) error, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterTransactionCtx", ctx, stage, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterTransactionCtx indicates an expected call of FirewallFilterTransactionCtx.
+func (mr *MockFirewallFilterAPIMockRecorder) FirewallFilterTransactionCtx(ctx, stage, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterTransactionCtx", reflect.TypeOf((*MockFirewallFilterAPI)(nil).FirewallFilterTransactionCtx), ctx, stage, check)
+}
+
+// MockFirewallCategoryAPI is a mock of FirewallCategoryAPI interface.
+type MockFirewallCategoryAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallCategoryAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallCategoryAPIMockRecorder is the mock recorder for MockFirewallCategoryAPI.
+type MockFirewallCategoryAPIMockRecorder struct {
+	mock *MockFirewallCategoryAPI
+}
+
+// NewMockFirewallCategoryAPI creates a new mock instance.
+func NewMockFirewallCategoryAPI(ctrl *gomock.Controller) *MockFirewallCategoryAPI {
+	mock := &MockFirewallCategoryAPI{ctrl: ctrl}
+	mock.recorder = &MockFirewallCategoryAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallCategoryAPI) EXPECT() *MockFirewallCategoryAPIMockRecorder {
+	return m.recorder
+}
+
+// FirewallCategoryAdd mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryAdd(category *opnsense.Category) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryAdd", category)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryAdd indicates an expected call of FirewallCategoryAdd.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryAdd(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryAdd", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryAdd), category)
+}
+
+// FirewallCategoryAddCtx mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryAddCtx(ctx context.Context, category *opnsense.Category) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryAddCtx", ctx, category)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryAddCtx indicates an expected call of FirewallCategoryAddCtx.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryAddCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryAddCtx", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryAddCtx), ctx, category)
+}
+
+// FirewallCategoryDelete mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategoryDelete indicates an expected call of FirewallCategoryDelete.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryDelete", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryDelete), arg0)
+}
+
+// FirewallCategoryDeleteCtx mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategoryDeleteCtx indicates an expected call of FirewallCategoryDeleteCtx.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryDeleteCtx", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryDeleteCtx), ctx, arg1)
+}
+
+// FirewallCategoryFindByName mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryFindByName(name string) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryFindByName", name)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryFindByName indicates an expected call of FirewallCategoryFindByName.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryFindByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryFindByName", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryFindByName), name)
+}
+
+// FirewallCategoryFindByNameCtx mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryFindByNameCtx(ctx context.Context, name string) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryFindByNameCtx", ctx, name)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryFindByNameCtx indicates an expected call of FirewallCategoryFindByNameCtx.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryFindByNameCtx(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryFindByNameCtx", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryFindByNameCtx), ctx, name)
+}
+
+// FirewallCategoryGet mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryGet(arg0 uuid.UUID) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryGet", arg0)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryGet indicates an expected call of FirewallCategoryGet.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryGet", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryGet), arg0)
+}
+
+// FirewallCategoryGetCtx mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategoryGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryGetCtx indicates an expected call of FirewallCategoryGetCtx.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategoryGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryGetCtx", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategoryGetCtx), ctx, arg1)
+}
+
+// FirewallCategorySearch mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategorySearch() ([]*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySearch")
+	ret0, _ := ret[0].([]*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategorySearch indicates an expected call of FirewallCategorySearch.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategorySearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySearch", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategorySearch))
+}
+
+// FirewallCategorySearchAll mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategorySearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.Category, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.Category, error])
+	return ret0
+}
+
+// FirewallCategorySearchAll indicates an expected call of FirewallCategorySearchAll.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategorySearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySearchAll", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategorySearchAll), ctx, opts)
+}
+
+// FirewallCategorySearchCtx mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategorySearchCtx(ctx context.Context) ([]*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategorySearchCtx indicates an expected call of FirewallCategorySearchCtx.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategorySearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySearchCtx", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategorySearchCtx), ctx)
+}
+
+// FirewallCategorySet mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategorySet(category *opnsense.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySet", category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategorySet indicates an expected call of FirewallCategorySet.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategorySet(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySet", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategorySet), category)
+}
+
+// FirewallCategorySetCtx mocks base method.
+func (m *MockFirewallCategoryAPI) FirewallCategorySetCtx(ctx context.Context, category *opnsense.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySetCtx", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategorySetCtx indicates an expected call of FirewallCategorySetCtx.
+func (mr *MockFirewallCategoryAPIMockRecorder) FirewallCategorySetCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySetCtx", reflect.TypeOf((*MockFirewallCategoryAPI)(nil).FirewallCategorySetCtx), ctx, category)
+}
+
+// MockInterfacesAPI is a mock of InterfacesAPI interface.
+type MockInterfacesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterfacesAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockInterfacesAPIMockRecorder is the mock recorder for MockInterfacesAPI.
+type MockInterfacesAPIMockRecorder struct {
+	mock *MockInterfacesAPI
+}
+
+// NewMockInterfacesAPI creates a new mock instance.
+func NewMockInterfacesAPI(ctrl *gomock.Controller) *MockInterfacesAPI {
+	mock := &MockInterfacesAPI{ctrl: ctrl}
+	mock.recorder = &MockInterfacesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterfacesAPI) EXPECT() *MockInterfacesAPIMockRecorder {
+	return m.recorder
+}
+
+// InterfaceGroupAdd mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupAdd(group *opnsense.InterfaceGroup) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupAdd", group)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupAdd indicates an expected call of InterfaceGroupAdd.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupAdd(group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupAdd", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupAdd), group)
+}
+
+// InterfaceGroupAddCtx mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupAddCtx(ctx context.Context, group *opnsense.InterfaceGroup) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupAddCtx", ctx, group)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupAddCtx indicates an expected call of InterfaceGroupAddCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupAddCtx(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupAddCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupAddCtx), ctx, group)
+}
+
+// InterfaceGroupDelete mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupDelete indicates an expected call of InterfaceGroupDelete.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupDelete", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupDelete), arg0)
+}
+
+// InterfaceGroupDeleteCtx mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupDeleteCtx indicates an expected call of InterfaceGroupDeleteCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupDeleteCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupDeleteCtx), ctx, arg1)
+}
+
+// InterfaceGroupGet mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupGet(arg0 uuid.UUID) (*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupGet", arg0)
+	ret0, _ := ret[0].(*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupGet indicates an expected call of InterfaceGroupGet.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupGet", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupGet), arg0)
+}
+
+// InterfaceGroupGetCtx mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupGetCtx indicates an expected call of InterfaceGroupGetCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupGetCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupGetCtx), ctx, arg1)
+}
+
+// InterfaceGroupReconfigure mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupReconfigure() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupReconfigure")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupReconfigure indicates an expected call of InterfaceGroupReconfigure.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupReconfigure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupReconfigure", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupReconfigure))
+}
+
+// InterfaceGroupReconfigureCtx mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupReconfigureCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupReconfigureCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupReconfigureCtx indicates an expected call of InterfaceGroupReconfigureCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupReconfigureCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupReconfigureCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupReconfigureCtx), ctx)
+}
+
+// InterfaceGroupSearch mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupSearch() ([]*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSearch")
+	ret0, _ := ret[0].([]*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupSearch indicates an expected call of InterfaceGroupSearch.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSearch", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupSearch))
+}
+
+// InterfaceGroupSearchAll mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.InterfaceGroup, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.InterfaceGroup, error])
+	return ret0
+}
+
+// InterfaceGroupSearchAll indicates an expected call of InterfaceGroupSearchAll.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSearchAll", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupSearchAll), ctx, opts)
+}
+
+// InterfaceGroupSearchCtx mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupSearchCtx(ctx context.Context) ([]*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupSearchCtx indicates an expected call of InterfaceGroupSearchCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSearchCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupSearchCtx), ctx)
+}
+
+// InterfaceGroupSet mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupSet(group *opnsense.InterfaceGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSet", group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupSet indicates an expected call of InterfaceGroupSet.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupSet(group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSet", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupSet), group)
+}
+
+// InterfaceGroupSetCtx mocks base method.
+func (m *MockInterfacesAPI) InterfaceGroupSetCtx(ctx context.Context, group *opnsense.InterfaceGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSetCtx", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupSetCtx indicates an expected call of InterfaceGroupSetCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfaceGroupSetCtx(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSetCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfaceGroupSetCtx), ctx, group)
+}
+
+// InterfacesList mocks base method.
+func (m *MockInterfacesAPI) InterfacesList() ([]*opnsense.InterfaceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesList")
+	ret0, _ := ret[0].([]*opnsense.InterfaceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfacesList indicates an expected call of InterfacesList.
+func (mr *MockInterfacesAPIMockRecorder) InterfacesList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesList", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfacesList))
+}
+
+// InterfacesListCtx mocks base method.
+func (m *MockInterfacesAPI) InterfacesListCtx(ctx context.Context) ([]*opnsense.InterfaceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.InterfaceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfacesListCtx indicates an expected call of InterfacesListCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfacesListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesListCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfacesListCtx), ctx)
+}
+
+// InterfacesValidate mocks base method.
+func (m *MockInterfacesAPI) InterfacesValidate(iface opnsense.Interface) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesValidate", iface)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfacesValidate indicates an expected call of InterfacesValidate.
+func (mr *MockInterfacesAPIMockRecorder) InterfacesValidate(iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesValidate", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfacesValidate), iface)
+}
+
+// InterfacesValidateCtx mocks base method.
+func (m *MockInterfacesAPI) InterfacesValidateCtx(ctx context.Context, iface opnsense.Interface) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesValidateCtx", ctx, iface)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfacesValidateCtx indicates an expected call of InterfacesValidateCtx.
+func (mr *MockInterfacesAPIMockRecorder) InterfacesValidateCtx(ctx, iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesValidateCtx", reflect.TypeOf((*MockInterfacesAPI)(nil).InterfacesValidateCtx), ctx, iface)
+}
+
+// MockFirewallNatAPI is a mock of FirewallNatAPI interface.
+type MockFirewallNatAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallNatAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallNatAPIMockRecorder is the mock recorder for MockFirewallNatAPI.
+type MockFirewallNatAPIMockRecorder struct {
+	mock *MockFirewallNatAPI
+}
+
+// NewMockFirewallNatAPI creates a new mock instance.
+func NewMockFirewallNatAPI(ctrl *gomock.Controller) *MockFirewallNatAPI {
+	mock := &MockFirewallNatAPI{ctrl: ctrl}
+	mock.recorder = &MockFirewallNatAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallNatAPI) EXPECT() *MockFirewallNatAPIMockRecorder {
+	return m.recorder
+}
+
+// FirewallDestinationNatRuleAdd mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleAdd(rule *opnsense.DestinationNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAdd indicates an expected call of FirewallDestinationNatRuleAdd.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAdd", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleAdd), rule)
+}
+
+// FirewallDestinationNatRuleAddCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleAddCtx(ctx context.Context, rule *opnsense.DestinationNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAddCtx indicates an expected call of FirewallDestinationNatRuleAddCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAddCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleAddCtx), ctx, rule)
+}
+
+// FirewallDestinationNatRuleAddWithFilterRule mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleAddWithFilterRule(rule *opnsense.DestinationNatRule) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAddWithFilterRule", rule)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAddWithFilterRule indicates an expected call of FirewallDestinationNatRuleAddWithFilterRule.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleAddWithFilterRule(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAddWithFilterRule", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleAddWithFilterRule), rule)
+}
+
+// FirewallDestinationNatRuleAddWithFilterRuleCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleAddWithFilterRuleCtx(ctx context.Context, rule *opnsense.DestinationNatRule) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAddWithFilterRuleCtx", ctx, rule)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAddWithFilterRuleCtx indicates an expected call of FirewallDestinationNatRuleAddWithFilterRuleCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleAddWithFilterRuleCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAddWithFilterRuleCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleAddWithFilterRuleCtx), ctx, rule)
+}
+
+// FirewallDestinationNatRuleDelete mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleDelete indicates an expected call of FirewallDestinationNatRuleDelete.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleDelete", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleDelete), arg0)
+}
+
+// FirewallDestinationNatRuleDeleteCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleDeleteCtx indicates an expected call of FirewallDestinationNatRuleDeleteCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleDeleteCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallDestinationNatRuleGet mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleGet(arg0 uuid.UUID) (*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleGet indicates an expected call of FirewallDestinationNatRuleGet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleGet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleGet), arg0)
+}
+
+// FirewallDestinationNatRuleGetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleGetCtx indicates an expected call of FirewallDestinationNatRuleGetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleGetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleGetCtx), ctx, arg1)
+}
+
+// FirewallDestinationNatRuleSearch mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleSearch() ([]*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleSearch indicates an expected call of FirewallDestinationNatRuleSearch.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSearch", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleSearch))
+}
+
+// FirewallDestinationNatRuleSearchAll mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.DestinationNatRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.DestinationNatRule, error])
+	return ret0
+}
+
+// FirewallDestinationNatRuleSearchAll indicates an expected call of FirewallDestinationNatRuleSearchAll.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSearchAll", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleSearchAll), ctx, opts)
+}
+
+// FirewallDestinationNatRuleSearchCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleSearchCtx(ctx context.Context) ([]*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleSearchCtx indicates an expected call of FirewallDestinationNatRuleSearchCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSearchCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleSearchCtx), ctx)
+}
+
+// FirewallDestinationNatRuleSet mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleSet(rule *opnsense.DestinationNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleSet indicates an expected call of FirewallDestinationNatRuleSet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleSet), rule)
+}
+
+// FirewallDestinationNatRuleSetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleSetCtx(ctx context.Context, rule *opnsense.DestinationNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleSetCtx indicates an expected call of FirewallDestinationNatRuleSetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleSetCtx), ctx, rule)
+}
+
+// FirewallDestinationNatRuleToggle mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleToggle indicates an expected call of FirewallDestinationNatRuleToggle.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleToggle", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleToggle), arg0, enabled)
+}
+
+// FirewallDestinationNatRuleToggleCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallDestinationNatRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleToggleCtx indicates an expected call of FirewallDestinationNatRuleToggleCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallDestinationNatRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleToggleCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallDestinationNatRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallNptRuleAdd mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleAdd(rule *opnsense.NptRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleAdd indicates an expected call of FirewallNptRuleAdd.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleAdd", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleAdd), rule)
+}
+
+// FirewallNptRuleAddCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleAddCtx(ctx context.Context, rule *opnsense.NptRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleAddCtx indicates an expected call of FirewallNptRuleAddCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleAddCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleAddCtx), ctx, rule)
+}
+
+// FirewallNptRuleDelete mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleDelete indicates an expected call of FirewallNptRuleDelete.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleDelete", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleDelete), arg0)
+}
+
+// FirewallNptRuleDeleteCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleDeleteCtx indicates an expected call of FirewallNptRuleDeleteCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleDeleteCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallNptRuleGet mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleGet(arg0 uuid.UUID) (*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleGet indicates an expected call of FirewallNptRuleGet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleGet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleGet), arg0)
+}
+
+// FirewallNptRuleGetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleGetCtx indicates an expected call of FirewallNptRuleGetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleGetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleGetCtx), ctx, arg1)
+}
+
+// FirewallNptRuleSearch mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleSearch() ([]*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleSearch indicates an expected call of FirewallNptRuleSearch.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSearch", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleSearch))
+}
+
+// FirewallNptRuleSearchAll mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.NptRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.NptRule, error])
+	return ret0
+}
+
+// FirewallNptRuleSearchAll indicates an expected call of FirewallNptRuleSearchAll.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSearchAll", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleSearchAll), ctx, opts)
+}
+
+// FirewallNptRuleSearchCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleSearchCtx(ctx context.Context) ([]*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleSearchCtx indicates an expected call of FirewallNptRuleSearchCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSearchCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleSearchCtx), ctx)
+}
+
+// FirewallNptRuleSet mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleSet(rule *opnsense.NptRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleSet indicates an expected call of FirewallNptRuleSet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleSet), rule)
+}
+
+// FirewallNptRuleSetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleSetCtx(ctx context.Context, rule *opnsense.NptRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleSetCtx indicates an expected call of FirewallNptRuleSetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleSetCtx), ctx, rule)
+}
+
+// FirewallNptRuleToggle mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleToggle indicates an expected call of FirewallNptRuleToggle.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleToggle", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleToggle), arg0, enabled)
+}
+
+// FirewallNptRuleToggleCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallNptRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleToggleCtx indicates an expected call of FirewallNptRuleToggleCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallNptRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleToggleCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallNptRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallOneToOneRuleAdd mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleAdd(rule *opnsense.OneToOneRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleAdd indicates an expected call of FirewallOneToOneRuleAdd.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleAdd", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleAdd), rule)
+}
+
+// FirewallOneToOneRuleAddCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleAddCtx(ctx context.Context, rule *opnsense.OneToOneRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleAddCtx indicates an expected call of FirewallOneToOneRuleAddCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleAddCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleAddCtx), ctx, rule)
+}
+
+// FirewallOneToOneRuleDelete mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleDelete indicates an expected call of FirewallOneToOneRuleDelete.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleDelete", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleDelete), arg0)
+}
+
+// FirewallOneToOneRuleDeleteCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleDeleteCtx indicates an expected call of FirewallOneToOneRuleDeleteCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleDeleteCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallOneToOneRuleGet mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleGet(arg0 uuid.UUID) (*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleGet indicates an expected call of FirewallOneToOneRuleGet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleGet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleGet), arg0)
+}
+
+// FirewallOneToOneRuleGetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleGetCtx indicates an expected call of FirewallOneToOneRuleGetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleGetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleGetCtx), ctx, arg1)
+}
+
+// FirewallOneToOneRuleSearch mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleSearch() ([]*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleSearch indicates an expected call of FirewallOneToOneRuleSearch.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSearch", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleSearch))
+}
+
+// FirewallOneToOneRuleSearchAll mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.OneToOneRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.OneToOneRule, error])
+	return ret0
+}
+
+// FirewallOneToOneRuleSearchAll indicates an expected call of FirewallOneToOneRuleSearchAll.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSearchAll", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleSearchAll), ctx, opts)
+}
+
+// FirewallOneToOneRuleSearchCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleSearchCtx(ctx context.Context) ([]*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleSearchCtx indicates an expected call of FirewallOneToOneRuleSearchCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSearchCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleSearchCtx), ctx)
+}
+
+// FirewallOneToOneRuleSet mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleSet(rule *opnsense.OneToOneRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleSet indicates an expected call of FirewallOneToOneRuleSet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleSet), rule)
+}
+
+// FirewallOneToOneRuleSetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleSetCtx(ctx context.Context, rule *opnsense.OneToOneRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleSetCtx indicates an expected call of FirewallOneToOneRuleSetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleSetCtx), ctx, rule)
+}
+
+// FirewallOneToOneRuleToggle mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleToggle indicates an expected call of FirewallOneToOneRuleToggle.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleToggle", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleToggle), arg0, enabled)
+}
+
+// FirewallOneToOneRuleToggleCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallOneToOneRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleToggleCtx indicates an expected call of FirewallOneToOneRuleToggleCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallOneToOneRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleToggleCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallOneToOneRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallSourceNatRuleAdd mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleAdd(rule *opnsense.SourceNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleAdd indicates an expected call of FirewallSourceNatRuleAdd.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleAdd", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleAdd), rule)
+}
+
+// FirewallSourceNatRuleAddCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleAddCtx(ctx context.Context, rule *opnsense.SourceNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleAddCtx indicates an expected call of FirewallSourceNatRuleAddCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleAddCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleAddCtx), ctx, rule)
+}
+
+// FirewallSourceNatRuleDelete mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleDelete indicates an expected call of FirewallSourceNatRuleDelete.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleDelete", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleDelete), arg0)
+}
+
+// FirewallSourceNatRuleDeleteCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleDeleteCtx indicates an expected call of FirewallSourceNatRuleDeleteCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleDeleteCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallSourceNatRuleGet mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleGet(arg0 uuid.UUID) (*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleGet indicates an expected call of FirewallSourceNatRuleGet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleGet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleGet), arg0)
+}
+
+// FirewallSourceNatRuleGetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleGetCtx indicates an expected call of FirewallSourceNatRuleGetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleGetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleGetCtx), ctx, arg1)
+}
+
+// FirewallSourceNatRuleSearch mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleSearch() ([]*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleSearch indicates an expected call of FirewallSourceNatRuleSearch.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSearch", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleSearch))
+}
+
+// FirewallSourceNatRuleSearchAll mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.SourceNatRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.SourceNatRule, error])
+	return ret0
+}
+
+// FirewallSourceNatRuleSearchAll indicates an expected call of FirewallSourceNatRuleSearchAll.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSearchAll", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleSearchAll), ctx, opts)
+}
+
+// FirewallSourceNatRuleSearchCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleSearchCtx(ctx context.Context) ([]*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleSearchCtx indicates an expected call of FirewallSourceNatRuleSearchCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSearchCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleSearchCtx), ctx)
+}
+
+// FirewallSourceNatRuleSet mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleSet(rule *opnsense.SourceNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleSet indicates an expected call of FirewallSourceNatRuleSet.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSet", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleSet), rule)
+}
+
+// FirewallSourceNatRuleSetCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleSetCtx(ctx context.Context, rule *opnsense.SourceNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleSetCtx indicates an expected call of FirewallSourceNatRuleSetCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSetCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleSetCtx), ctx, rule)
+}
+
+// FirewallSourceNatRuleToggle mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleToggle indicates an expected call of FirewallSourceNatRuleToggle.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleToggle", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleToggle), arg0, enabled)
+}
+
+// FirewallSourceNatRuleToggleCtx mocks base method.
+func (m *MockFirewallNatAPI) FirewallSourceNatRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleToggleCtx indicates an expected call of FirewallSourceNatRuleToggleCtx.
+func (mr *MockFirewallNatAPIMockRecorder) FirewallSourceNatRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleToggleCtx", reflect.TypeOf((*MockFirewallNatAPI)(nil).FirewallSourceNatRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// MockBgpAPI is a mock of BgpAPI interface.
+type MockBgpAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBgpAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockBgpAPIMockRecorder is the mock recorder for MockBgpAPI.
+type MockBgpAPIMockRecorder struct {
+	mock *MockBgpAPI
+}
+
+// NewMockBgpAPI creates a new mock instance.
+func NewMockBgpAPI(ctrl *gomock.Controller) *MockBgpAPI {
+	mock := &MockBgpAPI{ctrl: ctrl}
+	mock.recorder = &MockBgpAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBgpAPI) EXPECT() *MockBgpAPIMockRecorder {
+	return m.recorder
+}
+
+// BgpNeighborAdd mocks base method.
+func (m *MockBgpAPI) BgpNeighborAdd(clientConf opnsense.BgpNeighborSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborAdd", clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborAdd indicates an expected call of BgpNeighborAdd.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborAdd(clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborAdd", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborAdd), clientConf)
+}
+
+// BgpNeighborAddCtx mocks base method.
+func (m *MockBgpAPI) BgpNeighborAddCtx(ctx context.Context, clientConf opnsense.BgpNeighborSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborAddCtx", ctx, clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborAddCtx indicates an expected call of BgpNeighborAddCtx.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborAddCtx(ctx, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborAddCtx", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborAddCtx), ctx, clientConf)
+}
+
+// BgpNeighborDelete mocks base method.
+func (m *MockBgpAPI) BgpNeighborDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborDelete indicates an expected call of BgpNeighborDelete.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborDelete", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborDelete), arg0)
+}
+
+// BgpNeighborDeleteCtx mocks base method.
+func (m *MockBgpAPI) BgpNeighborDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborDeleteCtx indicates an expected call of BgpNeighborDeleteCtx.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborDeleteCtx", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborDeleteCtx), ctx, arg1)
+}
+
+// BgpNeighborGet mocks base method.
+func (m *MockBgpAPI) BgpNeighborGet(arg0 uuid.UUID) (*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGet", arg0)
+	ret0, _ := ret[0].(*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGet indicates an expected call of BgpNeighborGet.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGet", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborGet), arg0)
+}
+
+// BgpNeighborGetCtx mocks base method.
+func (m *MockBgpAPI) BgpNeighborGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGetCtx indicates an expected call of BgpNeighborGetCtx.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGetCtx", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborGetCtx), ctx, arg1)
+}
+
+// BgpNeighborGetUUIDs mocks base method.
+func (m *MockBgpAPI) BgpNeighborGetUUIDs() ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGetUUIDs")
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGetUUIDs indicates an expected call of BgpNeighborGetUUIDs.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborGetUUIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGetUUIDs", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborGetUUIDs))
+}
+
+// BgpNeighborGetUUIDsCtx mocks base method.
+func (m *MockBgpAPI) BgpNeighborGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGetUUIDsCtx", ctx)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGetUUIDsCtx indicates an expected call of BgpNeighborGetUUIDsCtx.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborGetUUIDsCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGetUUIDsCtx", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborGetUUIDsCtx), ctx)
+}
+
+// BgpNeighborList mocks base method.
+func (m *MockBgpAPI) BgpNeighborList() ([]*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborList")
+	ret0, _ := ret[0].([]*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborList indicates an expected call of BgpNeighborList.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborList", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborList))
+}
+
+// BgpNeighborListCtx mocks base method.
+func (m *MockBgpAPI) BgpNeighborListCtx(ctx context.Context) ([]*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborListCtx indicates an expected call of BgpNeighborListCtx.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborListCtx", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborListCtx), ctx)
+}
+
+// BgpNeighborSet mocks base method.
+func (m *MockBgpAPI) BgpNeighborSet(arg0 uuid.UUID, clientConf opnsense.BgpNeighborSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborSet", arg0, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborSet indicates an expected call of BgpNeighborSet.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborSet(arg0, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborSet", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborSet), arg0, clientConf)
+}
+
+// BgpNeighborSetCtx mocks base method.
+func (m *MockBgpAPI) BgpNeighborSetCtx(ctx context.Context, arg1 uuid.UUID, clientConf opnsense.BgpNeighborSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborSetCtx", ctx, arg1, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborSetCtx indicates an expected call of BgpNeighborSetCtx.
+func (mr *MockBgpAPIMockRecorder) BgpNeighborSetCtx(ctx, arg1, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborSetCtx", reflect.TypeOf((*MockBgpAPI)(nil).BgpNeighborSetCtx), ctx, arg1, clientConf)
+}
+
+// MockWireGuardAPI is a mock of WireGuardAPI interface.
+type MockWireGuardAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockWireGuardAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockWireGuardAPIMockRecorder is the mock recorder for MockWireGuardAPI.
+type MockWireGuardAPIMockRecorder struct {
+	mock *MockWireGuardAPI
+}
+
+// NewMockWireGuardAPI creates a new mock instance.
+func NewMockWireGuardAPI(ctrl *gomock.Controller) *MockWireGuardAPI {
+	mock := &MockWireGuardAPI{ctrl: ctrl}
+	mock.recorder = &MockWireGuardAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWireGuardAPI) EXPECT() *MockWireGuardAPIMockRecorder {
+	return m.recorder
+}
+
+// WireGuardClientAdd mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientAdd(clientConf opnsense.WireGuardClientSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientAdd", clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientAdd indicates an expected call of WireGuardClientAdd.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientAdd(clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientAdd", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientAdd), clientConf)
+}
+
+// WireGuardClientAddCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientAddCtx(ctx context.Context, clientConf opnsense.WireGuardClientSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientAddCtx", ctx, clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientAddCtx indicates an expected call of WireGuardClientAddCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientAddCtx(ctx, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientAddCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientAddCtx), ctx, clientConf)
+}
+
+// WireGuardClientDelete mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientDelete indicates an expected call of WireGuardClientDelete.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientDelete", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientDelete), arg0)
+}
+
+// WireGuardClientDeleteCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientDeleteCtx indicates an expected call of WireGuardClientDeleteCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientDeleteCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientDeleteCtx), ctx, arg1)
+}
+
+// WireGuardClientGet mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientGet(arg0 uuid.UUID) (*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGet", arg0)
+	ret0, _ := ret[0].(*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGet indicates an expected call of WireGuardClientGet.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGet", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientGet), arg0)
+}
+
+// WireGuardClientGetCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGetCtx indicates an expected call of WireGuardClientGetCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGetCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientGetCtx), ctx, arg1)
+}
+
+// WireGuardClientGetUUIDs mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientGetUUIDs() ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGetUUIDs")
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGetUUIDs indicates an expected call of WireGuardClientGetUUIDs.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientGetUUIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGetUUIDs", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientGetUUIDs))
+}
+
+// WireGuardClientGetUUIDsCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGetUUIDsCtx", ctx)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGetUUIDsCtx indicates an expected call of WireGuardClientGetUUIDsCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientGetUUIDsCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGetUUIDsCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientGetUUIDsCtx), ctx)
+}
+
+// WireGuardClientList mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientList() ([]*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientList")
+	ret0, _ := ret[0].([]*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientList indicates an expected call of WireGuardClientList.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientList", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientList))
+}
+
+// WireGuardClientListCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientListCtx(ctx context.Context) ([]*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientListCtx indicates an expected call of WireGuardClientListCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientListCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientListCtx), ctx)
+}
+
+// WireGuardClientSet mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientSet(arg0 uuid.UUID, clientConf opnsense.WireGuardClientSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientSet", arg0, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientSet indicates an expected call of WireGuardClientSet.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientSet(arg0, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientSet", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientSet), arg0, clientConf)
+}
+
+// WireGuardClientSetCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardClientSetCtx(ctx context.Context, arg1 uuid.UUID, clientConf opnsense.WireGuardClientSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientSetCtx", ctx, arg1, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientSetCtx indicates an expected call of WireGuardClientSetCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardClientSetCtx(ctx, arg1, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientSetCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardClientSetCtx), ctx, arg1, clientConf)
+}
+
+// WireGuardDisableService mocks base method.
+func (m *MockWireGuardAPI) WireGuardDisableService() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardDisableService")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardDisableService indicates an expected call of WireGuardDisableService.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardDisableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardDisableService", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardDisableService))
+}
+
+// WireGuardDisableServiceCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardDisableServiceCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardDisableServiceCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardDisableServiceCtx indicates an expected call of WireGuardDisableServiceCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardDisableServiceCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardDisableServiceCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardDisableServiceCtx), ctx)
+}
+
+// WireGuardEnableService mocks base method.
+func (m *MockWireGuardAPI) WireGuardEnableService() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardEnableService")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardEnableService indicates an expected call of WireGuardEnableService.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardEnableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardEnableService", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardEnableService))
+}
+
+// WireGuardEnableServiceCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardEnableServiceCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardEnableServiceCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardEnableServiceCtx indicates an expected call of WireGuardEnableServiceCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardEnableServiceCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardEnableServiceCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardEnableServiceCtx), ctx)
+}
+
+// WireGuardRestart mocks base method.
+func (m *MockWireGuardAPI) WireGuardRestart() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardRestart")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardRestart indicates an expected call of WireGuardRestart.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardRestart() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardRestart", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardRestart))
+}
+
+// WireGuardRestartCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardRestartCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardRestartCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardRestartCtx indicates an expected call of WireGuardRestartCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardRestartCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardRestartCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardRestartCtx), ctx)
+}
+
+// WireGuardServerAdd mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerAdd(serverConf opnsense.WireGuardServerSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerAdd", serverConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerAdd indicates an expected call of WireGuardServerAdd.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerAdd(serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerAdd", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerAdd), serverConf)
+}
+
+// WireGuardServerAddCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerAddCtx(ctx context.Context, serverConf opnsense.WireGuardServerSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerAddCtx", ctx, serverConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerAddCtx indicates an expected call of WireGuardServerAddCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerAddCtx(ctx, serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerAddCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerAddCtx), ctx, serverConf)
+}
+
+// WireGuardServerDelete mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerDelete indicates an expected call of WireGuardServerDelete.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerDelete", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerDelete), arg0)
+}
+
+// WireGuardServerDeleteCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerDeleteCtx indicates an expected call of WireGuardServerDeleteCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerDeleteCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerDeleteCtx), ctx, arg1)
+}
+
+// WireGuardServerFindUUIDByName mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerFindUUIDByName(name string) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerFindUUIDByName", name)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerFindUUIDByName indicates an expected call of WireGuardServerFindUUIDByName.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerFindUUIDByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerFindUUIDByName", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerFindUUIDByName), name)
+}
+
+// WireGuardServerFindUUIDByNameCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerFindUUIDByNameCtx(ctx context.Context, name string) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerFindUUIDByNameCtx", ctx, name)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerFindUUIDByNameCtx indicates an expected call of WireGuardServerFindUUIDByNameCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerFindUUIDByNameCtx(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerFindUUIDByNameCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerFindUUIDByNameCtx), ctx, name)
+}
+
+// WireGuardServerGet mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerGet(arg0 uuid.UUID) (*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGet", arg0)
+	ret0, _ := ret[0].(*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGet indicates an expected call of WireGuardServerGet.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGet", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerGet), arg0)
+}
+
+// WireGuardServerGetCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGetCtx indicates an expected call of WireGuardServerGetCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGetCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerGetCtx), ctx, arg1)
+}
+
+// WireGuardServerGetUUIDs mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerGetUUIDs() ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGetUUIDs")
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGetUUIDs indicates an expected call of WireGuardServerGetUUIDs.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerGetUUIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGetUUIDs", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerGetUUIDs))
+}
+
+// WireGuardServerGetUUIDsCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGetUUIDsCtx", ctx)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGetUUIDsCtx indicates an expected call of WireGuardServerGetUUIDsCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerGetUUIDsCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGetUUIDsCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerGetUUIDsCtx), ctx)
+}
+
+// WireGuardServerList mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerList() ([]*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerList")
+	ret0, _ := ret[0].([]*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerList indicates an expected call of WireGuardServerList.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerList", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerList))
+}
+
+// WireGuardServerListCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerListCtx(ctx context.Context) ([]*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerListCtx indicates an expected call of WireGuardServerListCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerListCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerListCtx), ctx)
+}
+
+// WireGuardServerSet mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerSet(arg0 uuid.UUID, serverConf opnsense.WireGuardServerSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerSet", arg0, serverConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerSet indicates an expected call of WireGuardServerSet.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerSet(arg0, serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerSet", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerSet), arg0, serverConf)
+}
+
+// WireGuardServerSetCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardServerSetCtx(ctx context.Context, arg1 uuid.UUID, serverConf opnsense.WireGuardServerSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerSetCtx", ctx, arg1, serverConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerSetCtx indicates an expected call of WireGuardServerSetCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardServerSetCtx(ctx, arg1, serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerSetCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardServerSetCtx), ctx, arg1, serverConf)
+}
+
+// WireGuardSettingsGet mocks base method.
+func (m *MockWireGuardAPI) WireGuardSettingsGet() (*opnsense.WireGuardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsGet")
+	ret0, _ := ret[0].(*opnsense.WireGuardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsGet indicates an expected call of WireGuardSettingsGet.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardSettingsGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsGet", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardSettingsGet))
+}
+
+// WireGuardSettingsGetCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardSettingsGetCtx(ctx context.Context) (*opnsense.WireGuardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsGetCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.WireGuardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsGetCtx indicates an expected call of WireGuardSettingsGetCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardSettingsGetCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsGetCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardSettingsGetCtx), ctx)
+}
+
+// WireGuardSettingsSet mocks base method.
+func (m *MockWireGuardAPI) WireGuardSettingsSet(settings opnsense.WireGuardSettings) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsSet", settings)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsSet indicates an expected call of WireGuardSettingsSet.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardSettingsSet(settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsSet", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardSettingsSet), settings)
+}
+
+// WireGuardSettingsSetCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardSettingsSetCtx(ctx context.Context, settings opnsense.WireGuardSettings) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsSetCtx", ctx, settings)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsSetCtx indicates an expected call of WireGuardSettingsSetCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardSettingsSetCtx(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsSetCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardSettingsSetCtx), ctx, settings)
+}
+
+// WireGuardShowConfig mocks base method.
+func (m *MockWireGuardAPI) WireGuardShowConfig() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowConfig")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowConfig indicates an expected call of WireGuardShowConfig.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardShowConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowConfig", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardShowConfig))
+}
+
+// WireGuardShowConfigCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardShowConfigCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowConfigCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowConfigCtx indicates an expected call of WireGuardShowConfigCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardShowConfigCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowConfigCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardShowConfigCtx), ctx)
+}
+
+// WireGuardShowHandshake mocks base method.
+func (m *MockWireGuardAPI) WireGuardShowHandshake() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowHandshake")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowHandshake indicates an expected call of WireGuardShowHandshake.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardShowHandshake() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowHandshake", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardShowHandshake))
+}
+
+// WireGuardShowHandshakeCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardShowHandshakeCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowHandshakeCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowHandshakeCtx indicates an expected call of WireGuardShowHandshakeCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardShowHandshakeCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowHandshakeCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardShowHandshakeCtx), ctx)
+}
+
+// WireGuardStart mocks base method.
+func (m *MockWireGuardAPI) WireGuardStart() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStart")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStart indicates an expected call of WireGuardStart.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardStart() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStart", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardStart))
+}
+
+// WireGuardStartCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardStartCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStartCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStartCtx indicates an expected call of WireGuardStartCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardStartCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStartCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardStartCtx), ctx)
+}
+
+// WireGuardStop mocks base method.
+func (m *MockWireGuardAPI) WireGuardStop() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStop")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStop indicates an expected call of WireGuardStop.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardStop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStop", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardStop))
+}
+
+// WireGuardStopCtx mocks base method.
+func (m *MockWireGuardAPI) WireGuardStopCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStopCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStopCtx indicates an expected call of WireGuardStopCtx.
+func (mr *MockWireGuardAPIMockRecorder) WireGuardStopCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStopCtx", reflect.TypeOf((*MockWireGuardAPI)(nil).WireGuardStopCtx), ctx)
+}
+
+// MockFirmwareAPI is a mock of FirmwareAPI interface.
+type MockFirmwareAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirmwareAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockFirmwareAPIMockRecorder is the mock recorder for MockFirmwareAPI.
+type MockFirmwareAPIMockRecorder struct {
+	mock *MockFirmwareAPI
+}
+
+// NewMockFirmwareAPI creates a new mock instance.
+func NewMockFirmwareAPI(ctrl *gomock.Controller) *MockFirmwareAPI {
+	mock := &MockFirmwareAPI{ctrl: ctrl}
+	mock.recorder = &MockFirmwareAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirmwareAPI) EXPECT() *MockFirmwareAPIMockRecorder {
+	return m.recorder
+}
+
+// Audit mocks base method.
+func (m *MockFirmwareAPI) Audit() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockFirmwareAPIMockRecorder) Audit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockFirmwareAPI)(nil).Audit))
+}
+
+// AuditCtx mocks base method.
+func (m *MockFirmwareAPI) AuditCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditCtx indicates an expected call of AuditCtx.
+func (mr *MockFirmwareAPIMockRecorder) AuditCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).AuditCtx), ctx)
+}
+
+// FirmwareConfigGet mocks base method.
+func (m *MockFirmwareAPI) FirmwareConfigGet() (*opnsense.FirmwareConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigGet")
+	ret0, _ := ret[0].(*opnsense.FirmwareConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigGet indicates an expected call of FirmwareConfigGet.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareConfigGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigGet", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareConfigGet))
+}
+
+// FirmwareConfigGetCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareConfigGetCtx(ctx context.Context) (*opnsense.FirmwareConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigGetCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.FirmwareConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigGetCtx indicates an expected call of FirmwareConfigGetCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareConfigGetCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigGetCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareConfigGetCtx), ctx)
+}
+
+// FirmwareConfigSet mocks base method.
+func (m *MockFirmwareAPI) FirmwareConfigSet(config opnsense.FirmwareConfig) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigSet", config)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigSet indicates an expected call of FirmwareConfigSet.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareConfigSet(config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigSet", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareConfigSet), config)
+}
+
+// FirmwareConfigSetCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareConfigSetCtx(ctx context.Context, config opnsense.FirmwareConfig) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigSetCtx", ctx, config)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigSetCtx indicates an expected call of FirmwareConfigSetCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareConfigSetCtx(ctx, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigSetCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareConfigSetCtx), ctx, config)
+}
+
+// FirmwareDetails mocks base method.
+func (m *MockFirmwareAPI) FirmwareDetails(packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareDetails", packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareDetails indicates an expected call of FirmwareDetails.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareDetails(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareDetails", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareDetails), packageName)
+}
+
+// FirmwareDetailsCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareDetailsCtx(ctx context.Context, packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareDetailsCtx", ctx, packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareDetailsCtx indicates an expected call of FirmwareDetailsCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareDetailsCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareDetailsCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareDetailsCtx), ctx, packageName)
+}
+
+// FirmwareInformation mocks base method.
+func (m *MockFirmwareAPI) FirmwareInformation() (*opnsense.Information, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInformation")
+	ret0, _ := ret[0].(*opnsense.Information)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInformation indicates an expected call of FirmwareInformation.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareInformation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInformation", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareInformation))
+}
+
+// FirmwareInformationCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareInformationCtx(ctx context.Context) (*opnsense.Information, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInformationCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.Information)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInformationCtx indicates an expected call of FirmwareInformationCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareInformationCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInformationCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareInformationCtx), ctx)
+}
+
+// FirmwareInstall mocks base method.
+func (m *MockFirmwareAPI) FirmwareInstall(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstall", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareInstall indicates an expected call of FirmwareInstall.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareInstall(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstall", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareInstall), packageName)
+}
+
+// FirmwareInstallCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareInstallCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstallCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareInstallCtx indicates an expected call of FirmwareInstallCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareInstallCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstallCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareInstallCtx), ctx, packageName)
+}
+
+// FirmwareInstalledPluginsList mocks base method.
+func (m *MockFirmwareAPI) FirmwareInstalledPluginsList() ([]opnsense.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstalledPluginsList")
+	ret0, _ := ret[0].([]opnsense.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInstalledPluginsList indicates an expected call of FirmwareInstalledPluginsList.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareInstalledPluginsList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstalledPluginsList", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareInstalledPluginsList))
+}
+
+// FirmwareInstalledPluginsListCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareInstalledPluginsListCtx(ctx context.Context) ([]opnsense.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstalledPluginsListCtx", ctx)
+	ret0, _ := ret[0].([]opnsense.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInstalledPluginsListCtx indicates an expected call of FirmwareInstalledPluginsListCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareInstalledPluginsListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstalledPluginsListCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareInstalledPluginsListCtx), ctx)
+}
+
+// FirmwareLicense mocks base method.
+func (m *MockFirmwareAPI) FirmwareLicense(packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLicense", packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareLicense indicates an expected call of FirmwareLicense.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareLicense(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLicense", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareLicense), packageName)
+}
+
+// FirmwareLicenseCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareLicenseCtx(ctx context.Context, packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLicenseCtx", ctx, packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareLicenseCtx indicates an expected call of FirmwareLicenseCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareLicenseCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLicenseCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareLicenseCtx), ctx, packageName)
+}
+
+// FirmwareLock mocks base method.
+func (m *MockFirmwareAPI) FirmwareLock(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLock", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareLock indicates an expected call of FirmwareLock.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareLock(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLock", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareLock), packageName)
+}
+
+// FirmwareLockCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareLockCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLockCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareLockCtx indicates an expected call of FirmwareLockCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareLockCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLockCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareLockCtx), ctx, packageName)
+}
+
+// FirmwareOptionsGet mocks base method.
+func (m *MockFirmwareAPI) FirmwareOptionsGet() (*opnsense.FirmwareOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareOptionsGet")
+	ret0, _ := ret[0].(*opnsense.FirmwareOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareOptionsGet indicates an expected call of FirmwareOptionsGet.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareOptionsGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareOptionsGet", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareOptionsGet))
+}
+
+// FirmwareOptionsGetCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareOptionsGetCtx(ctx context.Context) (*opnsense.FirmwareOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareOptionsGetCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.FirmwareOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareOptionsGetCtx indicates an expected call of FirmwareOptionsGetCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareOptionsGetCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareOptionsGetCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareOptionsGetCtx), ctx)
+}
+
+// FirmwareReInstall mocks base method.
+func (m *MockFirmwareAPI) FirmwareReInstall(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareReInstall", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareReInstall indicates an expected call of FirmwareReInstall.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareReInstall(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareReInstall", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareReInstall), packageName)
+}
+
+// FirmwareReInstallCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareReInstallCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareReInstallCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareReInstallCtx indicates an expected call of FirmwareReInstallCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareReInstallCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareReInstallCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareReInstallCtx), ctx, packageName)
+}
+
+// FirmwareRemove mocks base method.
+func (m *MockFirmwareAPI) FirmwareRemove(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareRemove", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareRemove indicates an expected call of FirmwareRemove.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareRemove(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareRemove", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareRemove), packageName)
+}
+
+// FirmwareRemoveCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareRemoveCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareRemoveCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareRemoveCtx indicates an expected call of FirmwareRemoveCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareRemoveCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareRemoveCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareRemoveCtx), ctx, packageName)
+}
+
+// FirmwareStatus mocks base method.
+func (m *MockFirmwareAPI) FirmwareStatus() (*opnsense.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareStatus")
+	ret0, _ := ret[0].(*opnsense.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareStatus indicates an expected call of FirmwareStatus.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareStatus", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareStatus))
+}
+
+// FirmwareStatusCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareStatusCtx(ctx context.Context) (*opnsense.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareStatusCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareStatusCtx indicates an expected call of FirmwareStatusCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareStatusCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareStatusCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareStatusCtx), ctx)
+}
+
+// FirmwareUnlock mocks base method.
+func (m *MockFirmwareAPI) FirmwareUnlock(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUnlock", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareUnlock indicates an expected call of FirmwareUnlock.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareUnlock(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUnlock", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareUnlock), packageName)
+}
+
+// FirmwareUnlockCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareUnlockCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUnlockCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareUnlockCtx indicates an expected call of FirmwareUnlockCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareUnlockCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUnlockCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareUnlockCtx), ctx, packageName)
+}
+
+// FirmwareUpgradeStatus mocks base method.
+func (m *MockFirmwareAPI) FirmwareUpgradeStatus() (*opnsense.UpgradeStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUpgradeStatus")
+	ret0, _ := ret[0].(*opnsense.UpgradeStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareUpgradeStatus indicates an expected call of FirmwareUpgradeStatus.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareUpgradeStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUpgradeStatus", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareUpgradeStatus))
+}
+
+// FirmwareUpgradeStatusCtx mocks base method.
+func (m *MockFirmwareAPI) FirmwareUpgradeStatusCtx(ctx context.Context) (*opnsense.UpgradeStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUpgradeStatusCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.UpgradeStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareUpgradeStatusCtx indicates an expected call of FirmwareUpgradeStatusCtx.
+func (mr *MockFirmwareAPIMockRecorder) FirmwareUpgradeStatusCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUpgradeStatusCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).FirmwareUpgradeStatusCtx), ctx)
+}
+
+// PowerOff mocks base method.
+func (m *MockFirmwareAPI) PowerOff() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOff")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PowerOff indicates an expected call of PowerOff.
+func (mr *MockFirmwareAPIMockRecorder) PowerOff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOff", reflect.TypeOf((*MockFirmwareAPI)(nil).PowerOff))
+}
+
+// PowerOffCtx mocks base method.
+func (m *MockFirmwareAPI) PowerOffCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOffCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PowerOffCtx indicates an expected call of PowerOffCtx.
+func (mr *MockFirmwareAPIMockRecorder) PowerOffCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOffCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).PowerOffCtx), ctx)
+}
+
+// Reboot mocks base method.
+func (m *MockFirmwareAPI) Reboot() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockFirmwareAPIMockRecorder) Reboot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockFirmwareAPI)(nil).Reboot))
+}
+
+// RebootCtx mocks base method.
+func (m *MockFirmwareAPI) RebootCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootCtx indicates an expected call of RebootCtx.
+func (mr *MockFirmwareAPIMockRecorder) RebootCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).RebootCtx), ctx)
+}
+
+// Upgrade mocks base method.
+func (m *MockFirmwareAPI) Upgrade() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockFirmwareAPIMockRecorder) Upgrade() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockFirmwareAPI)(nil).Upgrade))
+}
+
+// UpgradeCtx mocks base method.
+func (m *MockFirmwareAPI) UpgradeCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeCtx indicates an expected call of UpgradeCtx.
+func (mr *MockFirmwareAPIMockRecorder) UpgradeCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).UpgradeCtx), ctx)
+}
+
+// UpgradeStatus mocks base method.
+func (m *MockFirmwareAPI) UpgradeStatus() (*opnsense.UpgradeStatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeStatus")
+	ret0, _ := ret[0].(*opnsense.UpgradeStatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeStatus indicates an expected call of UpgradeStatus.
+func (mr *MockFirmwareAPIMockRecorder) UpgradeStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeStatus", reflect.TypeOf((*MockFirmwareAPI)(nil).UpgradeStatus))
+}
+
+// UpgradeStatusCtx mocks base method.
+func (m *MockFirmwareAPI) UpgradeStatusCtx(ctx context.Context) (*opnsense.UpgradeStatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeStatusCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.UpgradeStatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeStatusCtx indicates an expected call of UpgradeStatusCtx.
+func (mr *MockFirmwareAPIMockRecorder) UpgradeStatusCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeStatusCtx", reflect.TypeOf((*MockFirmwareAPI)(nil).UpgradeStatusCtx), ctx)
+}
+
+// MockBackupAPI is a mock of BackupAPI interface.
+type MockBackupAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockBackupAPIMockRecorder is the mock recorder for MockBackupAPI.
+type MockBackupAPIMockRecorder struct {
+	mock *MockBackupAPI
+}
+
+// NewMockBackupAPI creates a new mock instance.
+func NewMockBackupAPI(ctrl *gomock.Controller) *MockBackupAPI {
+	mock := &MockBackupAPI{ctrl: ctrl}
+	mock.recorder = &MockBackupAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupAPI) EXPECT() *MockBackupAPIMockRecorder {
+	return m.recorder
+}
+
+// Backup mocks base method.
+func (m *MockBackupAPI) Backup() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockBackupAPIMockRecorder) Backup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockBackupAPI)(nil).Backup))
+}
+
+// BackupCtx mocks base method.
+func (m *MockBackupAPI) BackupCtx(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupCtx", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupCtx indicates an expected call of BackupCtx.
+func (mr *MockBackupAPIMockRecorder) BackupCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupCtx", reflect.TypeOf((*MockBackupAPI)(nil).BackupCtx), ctx)
+}
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// AliasAdd mocks base method.
+func (m *MockAPI) AliasAdd(conf opnsense.AliasFormat) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasAdd", conf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasAdd indicates an expected call of AliasAdd.
+func (mr *MockAPIMockRecorder) AliasAdd(conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasAdd", reflect.TypeOf((*MockAPI)(nil).AliasAdd), conf)
+}
+
+// AliasAddCtx mocks base method.
+func (m *MockAPI) AliasAddCtx(ctx context.Context, conf opnsense.AliasFormat) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasAddCtx", ctx, conf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasAddCtx indicates an expected call of AliasAddCtx.
+func (mr *MockAPIMockRecorder) AliasAddCtx(ctx, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasAddCtx", reflect.TypeOf((*MockAPI)(nil).AliasAddCtx), ctx, conf)
+}
+
+// AliasDelete mocks base method.
+func (m *MockAPI) AliasDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasDelete indicates an expected call of AliasDelete.
+func (mr *MockAPIMockRecorder) AliasDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasDelete", reflect.TypeOf((*MockAPI)(nil).AliasDelete), arg0)
+}
+
+// AliasDeleteCtx mocks base method.
+func (m *MockAPI) AliasDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasDeleteCtx indicates an expected call of AliasDeleteCtx.
+func (mr *MockAPIMockRecorder) AliasDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasDeleteCtx", reflect.TypeOf((*MockAPI)(nil).AliasDeleteCtx), ctx, arg1)
+}
+
+// AliasGet mocks base method.
+func (m *MockAPI) AliasGet(arg0 uuid.UUID) (*opnsense.AliasFormat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGet", arg0)
+	ret0, _ := ret[0].(*opnsense.AliasFormat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGet indicates an expected call of AliasGet.
+func (mr *MockAPIMockRecorder) AliasGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGet", reflect.TypeOf((*MockAPI)(nil).AliasGet), arg0)
+}
+
+// AliasGetCtx mocks base method.
+func (m *MockAPI) AliasGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.AliasFormat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.AliasFormat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGetCtx indicates an expected call of AliasGetCtx.
+func (mr *MockAPIMockRecorder) AliasGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGetCtx", reflect.TypeOf((*MockAPI)(nil).AliasGetCtx), ctx, arg1)
+}
+
+// AliasGetList mocks base method.
+func (m *MockAPI) AliasGetList() (*opnsense.AliasList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGetList")
+	ret0, _ := ret[0].(*opnsense.AliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGetList indicates an expected call of AliasGetList.
+func (mr *MockAPIMockRecorder) AliasGetList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGetList", reflect.TypeOf((*MockAPI)(nil).AliasGetList))
+}
+
+// AliasGetListCtx mocks base method.
+func (m *MockAPI) AliasGetListCtx(ctx context.Context) (*opnsense.AliasList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasGetListCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.AliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasGetListCtx indicates an expected call of AliasGetListCtx.
+func (mr *MockAPIMockRecorder) AliasGetListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasGetListCtx", reflect.TypeOf((*MockAPI)(nil).AliasGetListCtx), ctx)
+}
+
+// AliasReconfigure mocks base method.
+func (m *MockAPI) AliasReconfigure() (*opnsense.AliasReconfigureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasReconfigure")
+	ret0, _ := ret[0].(*opnsense.AliasReconfigureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasReconfigure indicates an expected call of AliasReconfigure.
+func (mr *MockAPIMockRecorder) AliasReconfigure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasReconfigure", reflect.TypeOf((*MockAPI)(nil).AliasReconfigure))
+}
+
+// AliasReconfigureCtx mocks base method.
+func (m *MockAPI) AliasReconfigureCtx(ctx context.Context) (*opnsense.AliasReconfigureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasReconfigureCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.AliasReconfigureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasReconfigureCtx indicates an expected call of AliasReconfigureCtx.
+func (mr *MockAPIMockRecorder) AliasReconfigureCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasReconfigureCtx", reflect.TypeOf((*MockAPI)(nil).AliasReconfigureCtx), ctx)
+}
+
+// AliasSearchAll mocks base method.
+func (m *MockAPI) AliasSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[opnsense.AliasListItem, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[opnsense.AliasListItem, error])
+	return ret0
+}
+
+// AliasSearchAll indicates an expected call of AliasSearchAll.
+func (mr *MockAPIMockRecorder) AliasSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasSearchAll", reflect.TypeOf((*MockAPI)(nil).AliasSearchAll), ctx, opts)
+}
+
+// AliasUpdate mocks base method.
+func (m *MockAPI) AliasUpdate(arg0 uuid.UUID, conf opnsense.AliasFormat) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUpdate", arg0, conf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUpdate indicates an expected call of AliasUpdate.
+func (mr *MockAPIMockRecorder) AliasUpdate(arg0, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUpdate", reflect.TypeOf((*MockAPI)(nil).AliasUpdate), arg0, conf)
+}
+
+// AliasUpdateCtx mocks base method.
+func (m *MockAPI) AliasUpdateCtx(ctx context.Context, arg1 uuid.UUID, conf opnsense.AliasFormat) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUpdateCtx", ctx, arg1, conf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUpdateCtx indicates an expected call of AliasUpdateCtx.
+func (mr *MockAPIMockRecorder) AliasUpdateCtx(ctx, arg1, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUpdateCtx", reflect.TypeOf((*MockAPI)(nil).AliasUpdateCtx), ctx, arg1, conf)
+}
+
+// AliasUtilsAdd mocks base method.
+func (m *MockAPI) AliasUtilsAdd(name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsAdd", name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsAdd indicates an expected call of AliasUtilsAdd.
+func (mr *MockAPIMockRecorder) AliasUtilsAdd(name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsAdd", reflect.TypeOf((*MockAPI)(nil).AliasUtilsAdd), name, request)
+}
+
+// AliasUtilsAddCtx mocks base method.
+func (m *MockAPI) AliasUtilsAddCtx(ctx context.Context, name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsAddCtx", ctx, name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsAddCtx indicates an expected call of AliasUtilsAddCtx.
+func (mr *MockAPIMockRecorder) AliasUtilsAddCtx(ctx, name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsAddCtx", reflect.TypeOf((*MockAPI)(nil).AliasUtilsAddCtx), ctx, name, request)
+}
+
+// AliasUtilsDel mocks base method.
+func (m *MockAPI) AliasUtilsDel(name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsDel", name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsDel indicates an expected call of AliasUtilsDel.
+func (mr *MockAPIMockRecorder) AliasUtilsDel(name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsDel", reflect.TypeOf((*MockAPI)(nil).AliasUtilsDel), name, request)
+}
+
+// AliasUtilsDelCtx mocks base method.
+func (m *MockAPI) AliasUtilsDelCtx(ctx context.Context, name string, request opnsense.AliasUtilsSet) (*opnsense.AliasUtilsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsDelCtx", ctx, name, request)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsDelCtx indicates an expected call of AliasUtilsDelCtx.
+func (mr *MockAPIMockRecorder) AliasUtilsDelCtx(ctx, name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsDelCtx", reflect.TypeOf((*MockAPI)(nil).AliasUtilsDelCtx), ctx, name, request)
+}
+
+// AliasUtilsGet mocks base method.
+func (m *MockAPI) AliasUtilsGet(name string) (*opnsense.AliasUtilsGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsGet", name)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsGet indicates an expected call of AliasUtilsGet.
+func (mr *MockAPIMockRecorder) AliasUtilsGet(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsGet", reflect.TypeOf((*MockAPI)(nil).AliasUtilsGet), name)
+}
+
+// AliasUtilsGetCtx mocks base method.
+func (m *MockAPI) AliasUtilsGetCtx(ctx context.Context, name string) (*opnsense.AliasUtilsGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AliasUtilsGetCtx", ctx, name)
+	ret0, _ := ret[0].(*opnsense.AliasUtilsGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AliasUtilsGetCtx indicates an expected call of AliasUtilsGetCtx.
+func (mr *MockAPIMockRecorder) AliasUtilsGetCtx(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AliasUtilsGetCtx", reflect.TypeOf((*MockAPI)(nil).AliasUtilsGetCtx), ctx, name)
+}
+
+// Audit mocks base method.
+func (m *MockAPI) Audit() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockAPIMockRecorder) Audit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockAPI)(nil).Audit))
+}
+
+// AuditCtx mocks base method.
+func (m *MockAPI) AuditCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditCtx indicates an expected call of AuditCtx.
+func (mr *MockAPIMockRecorder) AuditCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditCtx", reflect.TypeOf((*MockAPI)(nil).AuditCtx), ctx)
+}
+
+// Backup mocks base method.
+func (m *MockAPI) Backup() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockAPIMockRecorder) Backup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockAPI)(nil).Backup))
+}
+
+// BackupCtx mocks base method.
+func (m *MockAPI) BackupCtx(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupCtx", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupCtx indicates an expected call of BackupCtx.
+func (mr *MockAPIMockRecorder) BackupCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupCtx", reflect.TypeOf((*MockAPI)(nil).BackupCtx), ctx)
+}
+
+// BgpNeighborAdd mocks base method.
+func (m *MockAPI) BgpNeighborAdd(clientConf opnsense.BgpNeighborSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborAdd", clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborAdd indicates an expected call of BgpNeighborAdd.
+func (mr *MockAPIMockRecorder) BgpNeighborAdd(clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborAdd", reflect.TypeOf((*MockAPI)(nil).BgpNeighborAdd), clientConf)
+}
+
+// BgpNeighborAddCtx mocks base method.
+func (m *MockAPI) BgpNeighborAddCtx(ctx context.Context, clientConf opnsense.BgpNeighborSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborAddCtx", ctx, clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborAddCtx indicates an expected call of BgpNeighborAddCtx.
+func (mr *MockAPIMockRecorder) BgpNeighborAddCtx(ctx, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborAddCtx", reflect.TypeOf((*MockAPI)(nil).BgpNeighborAddCtx), ctx, clientConf)
+}
+
+// BgpNeighborDelete mocks base method.
+func (m *MockAPI) BgpNeighborDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborDelete indicates an expected call of BgpNeighborDelete.
+func (mr *MockAPIMockRecorder) BgpNeighborDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborDelete", reflect.TypeOf((*MockAPI)(nil).BgpNeighborDelete), arg0)
+}
+
+// BgpNeighborDeleteCtx mocks base method.
+func (m *MockAPI) BgpNeighborDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborDeleteCtx indicates an expected call of BgpNeighborDeleteCtx.
+func (mr *MockAPIMockRecorder) BgpNeighborDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborDeleteCtx", reflect.TypeOf((*MockAPI)(nil).BgpNeighborDeleteCtx), ctx, arg1)
+}
+
+// BgpNeighborGet mocks base method.
+func (m *MockAPI) BgpNeighborGet(arg0 uuid.UUID) (*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGet", arg0)
+	ret0, _ := ret[0].(*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGet indicates an expected call of BgpNeighborGet.
+func (mr *MockAPIMockRecorder) BgpNeighborGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGet", reflect.TypeOf((*MockAPI)(nil).BgpNeighborGet), arg0)
+}
+
+// BgpNeighborGetCtx mocks base method.
+func (m *MockAPI) BgpNeighborGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGetCtx indicates an expected call of BgpNeighborGetCtx.
+func (mr *MockAPIMockRecorder) BgpNeighborGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGetCtx", reflect.TypeOf((*MockAPI)(nil).BgpNeighborGetCtx), ctx, arg1)
+}
+
+// BgpNeighborGetUUIDs mocks base method.
+func (m *MockAPI) BgpNeighborGetUUIDs() ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGetUUIDs")
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGetUUIDs indicates an expected call of BgpNeighborGetUUIDs.
+func (mr *MockAPIMockRecorder) BgpNeighborGetUUIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGetUUIDs", reflect.TypeOf((*MockAPI)(nil).BgpNeighborGetUUIDs))
+}
+
+// BgpNeighborGetUUIDsCtx mocks base method.
+func (m *MockAPI) BgpNeighborGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborGetUUIDsCtx", ctx)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborGetUUIDsCtx indicates an expected call of BgpNeighborGetUUIDsCtx.
+func (mr *MockAPIMockRecorder) BgpNeighborGetUUIDsCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborGetUUIDsCtx", reflect.TypeOf((*MockAPI)(nil).BgpNeighborGetUUIDsCtx), ctx)
+}
+
+// BgpNeighborList mocks base method.
+func (m *MockAPI) BgpNeighborList() ([]*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborList")
+	ret0, _ := ret[0].([]*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborList indicates an expected call of BgpNeighborList.
+func (mr *MockAPIMockRecorder) BgpNeighborList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborList", reflect.TypeOf((*MockAPI)(nil).BgpNeighborList))
+}
+
+// BgpNeighborListCtx mocks base method.
+func (m *MockAPI) BgpNeighborListCtx(ctx context.Context) ([]*opnsense.BgpNeighborGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.BgpNeighborGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborListCtx indicates an expected call of BgpNeighborListCtx.
+func (mr *MockAPIMockRecorder) BgpNeighborListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborListCtx", reflect.TypeOf((*MockAPI)(nil).BgpNeighborListCtx), ctx)
+}
+
+// BgpNeighborSet mocks base method.
+func (m *MockAPI) BgpNeighborSet(arg0 uuid.UUID, clientConf opnsense.BgpNeighborSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborSet", arg0, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborSet indicates an expected call of BgpNeighborSet.
+func (mr *MockAPIMockRecorder) BgpNeighborSet(arg0, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborSet", reflect.TypeOf((*MockAPI)(nil).BgpNeighborSet), arg0, clientConf)
+}
+
+// BgpNeighborSetCtx mocks base method.
+func (m *MockAPI) BgpNeighborSetCtx(ctx context.Context, arg1 uuid.UUID, clientConf opnsense.BgpNeighborSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BgpNeighborSetCtx", ctx, arg1, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BgpNeighborSetCtx indicates an expected call of BgpNeighborSetCtx.
+func (mr *MockAPIMockRecorder) BgpNeighborSetCtx(ctx, arg1, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BgpNeighborSetCtx", reflect.TypeOf((*MockAPI)(nil).BgpNeighborSetCtx), ctx, arg1, clientConf)
+}
+
+// FirewallCategoryAdd mocks base method.
+func (m *MockAPI) FirewallCategoryAdd(category *opnsense.Category) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryAdd", category)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryAdd indicates an expected call of FirewallCategoryAdd.
+func (mr *MockAPIMockRecorder) FirewallCategoryAdd(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryAdd", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryAdd), category)
+}
+
+// FirewallCategoryAddCtx mocks base method.
+func (m *MockAPI) FirewallCategoryAddCtx(ctx context.Context, category *opnsense.Category) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryAddCtx", ctx, category)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryAddCtx indicates an expected call of FirewallCategoryAddCtx.
+func (mr *MockAPIMockRecorder) FirewallCategoryAddCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryAddCtx", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryAddCtx), ctx, category)
+}
+
+// FirewallCategoryDelete mocks base method.
+func (m *MockAPI) FirewallCategoryDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategoryDelete indicates an expected call of FirewallCategoryDelete.
+func (mr *MockAPIMockRecorder) FirewallCategoryDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryDelete", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryDelete), arg0)
+}
+
+// FirewallCategoryDeleteCtx mocks base method.
+func (m *MockAPI) FirewallCategoryDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategoryDeleteCtx indicates an expected call of FirewallCategoryDeleteCtx.
+func (mr *MockAPIMockRecorder) FirewallCategoryDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryDeleteCtx", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryDeleteCtx), ctx, arg1)
+}
+
+// FirewallCategoryFindByName mocks base method.
+func (m *MockAPI) FirewallCategoryFindByName(name string) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryFindByName", name)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryFindByName indicates an expected call of FirewallCategoryFindByName.
+func (mr *MockAPIMockRecorder) FirewallCategoryFindByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryFindByName", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryFindByName), name)
+}
+
+// FirewallCategoryFindByNameCtx mocks base method.
+func (m *MockAPI) FirewallCategoryFindByNameCtx(ctx context.Context, name string) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryFindByNameCtx", ctx, name)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryFindByNameCtx indicates an expected call of FirewallCategoryFindByNameCtx.
+func (mr *MockAPIMockRecorder) FirewallCategoryFindByNameCtx(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryFindByNameCtx", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryFindByNameCtx), ctx, name)
+}
+
+// FirewallCategoryGet mocks base method.
+func (m *MockAPI) FirewallCategoryGet(arg0 uuid.UUID) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryGet", arg0)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryGet indicates an expected call of FirewallCategoryGet.
+func (mr *MockAPIMockRecorder) FirewallCategoryGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryGet", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryGet), arg0)
+}
+
+// FirewallCategoryGetCtx mocks base method.
+func (m *MockAPI) FirewallCategoryGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategoryGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategoryGetCtx indicates an expected call of FirewallCategoryGetCtx.
+func (mr *MockAPIMockRecorder) FirewallCategoryGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategoryGetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallCategoryGetCtx), ctx, arg1)
+}
+
+// FirewallCategorySearch mocks base method.
+func (m *MockAPI) FirewallCategorySearch() ([]*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySearch")
+	ret0, _ := ret[0].([]*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategorySearch indicates an expected call of FirewallCategorySearch.
+func (mr *MockAPIMockRecorder) FirewallCategorySearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySearch", reflect.TypeOf((*MockAPI)(nil).FirewallCategorySearch))
+}
+
+// FirewallCategorySearchAll mocks base method.
+func (m *MockAPI) FirewallCategorySearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.Category, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.Category, error])
+	return ret0
+}
+
+// FirewallCategorySearchAll indicates an expected call of FirewallCategorySearchAll.
+func (mr *MockAPIMockRecorder) FirewallCategorySearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySearchAll", reflect.TypeOf((*MockAPI)(nil).FirewallCategorySearchAll), ctx, opts)
+}
+
+// FirewallCategorySearchCtx mocks base method.
+func (m *MockAPI) FirewallCategorySearchCtx(ctx context.Context) ([]*opnsense.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallCategorySearchCtx indicates an expected call of FirewallCategorySearchCtx.
+func (mr *MockAPIMockRecorder) FirewallCategorySearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySearchCtx", reflect.TypeOf((*MockAPI)(nil).FirewallCategorySearchCtx), ctx)
+}
+
+// FirewallCategorySet mocks base method.
+func (m *MockAPI) FirewallCategorySet(category *opnsense.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySet", category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategorySet indicates an expected call of FirewallCategorySet.
+func (mr *MockAPIMockRecorder) FirewallCategorySet(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySet", reflect.TypeOf((*MockAPI)(nil).FirewallCategorySet), category)
+}
+
+// FirewallCategorySetCtx mocks base method.
+func (m *MockAPI) FirewallCategorySetCtx(ctx context.Context, category *opnsense.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallCategorySetCtx", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallCategorySetCtx indicates an expected call of FirewallCategorySetCtx.
+func (mr *MockAPIMockRecorder) FirewallCategorySetCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallCategorySetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallCategorySetCtx), ctx, category)
+}
+
+// FirewallDestinationNatRuleAdd mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleAdd(rule *opnsense.DestinationNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAdd indicates an expected call of FirewallDestinationNatRuleAdd.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAdd", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleAdd), rule)
+}
+
+// FirewallDestinationNatRuleAddCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleAddCtx(ctx context.Context, rule *opnsense.DestinationNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAddCtx indicates an expected call of FirewallDestinationNatRuleAddCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAddCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleAddCtx), ctx, rule)
+}
+
+// FirewallDestinationNatRuleAddWithFilterRule mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleAddWithFilterRule(rule *opnsense.DestinationNatRule) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAddWithFilterRule", rule)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAddWithFilterRule indicates an expected call of FirewallDestinationNatRuleAddWithFilterRule.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleAddWithFilterRule(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAddWithFilterRule", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleAddWithFilterRule), rule)
+}
+
+// FirewallDestinationNatRuleAddWithFilterRuleCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleAddWithFilterRuleCtx(ctx context.Context, rule *opnsense.DestinationNatRule) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleAddWithFilterRuleCtx", ctx, rule)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleAddWithFilterRuleCtx indicates an expected call of FirewallDestinationNatRuleAddWithFilterRuleCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleAddWithFilterRuleCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleAddWithFilterRuleCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleAddWithFilterRuleCtx), ctx, rule)
+}
+
+// FirewallDestinationNatRuleDelete mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleDelete indicates an expected call of FirewallDestinationNatRuleDelete.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleDelete", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleDelete), arg0)
+}
+
+// FirewallDestinationNatRuleDeleteCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleDeleteCtx indicates an expected call of FirewallDestinationNatRuleDeleteCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleDeleteCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallDestinationNatRuleGet mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleGet(arg0 uuid.UUID) (*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleGet indicates an expected call of FirewallDestinationNatRuleGet.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleGet", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleGet), arg0)
+}
+
+// FirewallDestinationNatRuleGetCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleGetCtx indicates an expected call of FirewallDestinationNatRuleGetCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleGetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleGetCtx), ctx, arg1)
+}
+
+// FirewallDestinationNatRuleSearch mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleSearch() ([]*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleSearch indicates an expected call of FirewallDestinationNatRuleSearch.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSearch", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleSearch))
+}
+
+// FirewallDestinationNatRuleSearchAll mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.DestinationNatRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.DestinationNatRule, error])
+	return ret0
+}
+
+// FirewallDestinationNatRuleSearchAll indicates an expected call of FirewallDestinationNatRuleSearchAll.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSearchAll", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleSearchAll), ctx, opts)
+}
+
+// FirewallDestinationNatRuleSearchCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleSearchCtx(ctx context.Context) ([]*opnsense.DestinationNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.DestinationNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleSearchCtx indicates an expected call of FirewallDestinationNatRuleSearchCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSearchCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleSearchCtx), ctx)
+}
+
+// FirewallDestinationNatRuleSet mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleSet(rule *opnsense.DestinationNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleSet indicates an expected call of FirewallDestinationNatRuleSet.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSet", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleSet), rule)
+}
+
+// FirewallDestinationNatRuleSetCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleSetCtx(ctx context.Context, rule *opnsense.DestinationNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallDestinationNatRuleSetCtx indicates an expected call of FirewallDestinationNatRuleSetCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleSetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleSetCtx), ctx, rule)
+}
+
+// FirewallDestinationNatRuleToggle mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleToggle indicates an expected call of FirewallDestinationNatRuleToggle.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleToggle", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleToggle), arg0, enabled)
+}
+
+// FirewallDestinationNatRuleToggleCtx mocks base method.
+func (m *MockAPI) FirewallDestinationNatRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallDestinationNatRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallDestinationNatRuleToggleCtx indicates an expected call of FirewallDestinationNatRuleToggleCtx.
+func (mr *MockAPIMockRecorder) FirewallDestinationNatRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallDestinationNatRuleToggleCtx", reflect.TypeOf((*MockAPI)(nil).FirewallDestinationNatRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallFilterApply mocks base method.
+func (m *MockAPI) FirewallFilterApply(rollbackRevision *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterApply", rollbackRevision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterApply indicates an expected call of FirewallFilterApply.
+func (mr *MockAPIMockRecorder) FirewallFilterApply(rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterApply", reflect.TypeOf((*MockAPI)(nil).FirewallFilterApply), rollbackRevision)
+}
+
+// FirewallFilterApplyCtx mocks base method.
+func (m *MockAPI) FirewallFilterApplyCtx(ctx context.Context, rollbackRevision *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterApplyCtx", ctx, rollbackRevision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterApplyCtx indicates an expected call of FirewallFilterApplyCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterApplyCtx(ctx, rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterApplyCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterApplyCtx), ctx, rollbackRevision)
+}
+
+// FirewallFilterCancelRollback mocks base method.
+func (m *MockAPI) FirewallFilterCancelRollback(rollbackRevision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterCancelRollback", rollbackRevision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterCancelRollback indicates an expected call of FirewallFilterCancelRollback.
+func (mr *MockAPIMockRecorder) FirewallFilterCancelRollback(rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterCancelRollback", reflect.TypeOf((*MockAPI)(nil).FirewallFilterCancelRollback), rollbackRevision)
+}
+
+// FirewallFilterCancelRollbackCtx mocks base method.
+func (m *MockAPI) FirewallFilterCancelRollbackCtx(ctx context.Context, rollbackRevision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterCancelRollbackCtx", ctx, rollbackRevision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterCancelRollbackCtx indicates an expected call of FirewallFilterCancelRollbackCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterCancelRollbackCtx(ctx, rollbackRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterCancelRollbackCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterCancelRollbackCtx), ctx, rollbackRevision)
+}
+
+// FirewallFilterRevert mocks base method.
+func (m *MockAPI) FirewallFilterRevert(revision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRevert", revision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRevert indicates an expected call of FirewallFilterRevert.
+func (mr *MockAPIMockRecorder) FirewallFilterRevert(revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRevert", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRevert), revision)
+}
+
+// FirewallFilterRevertCtx mocks base method.
+func (m *MockAPI) FirewallFilterRevertCtx(ctx context.Context, revision string) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRevertCtx", ctx, revision)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRevertCtx indicates an expected call of FirewallFilterRevertCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRevertCtx(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRevertCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRevertCtx), ctx, revision)
+}
+
+// FirewallFilterRuleAdd mocks base method.
+func (m *MockAPI) FirewallFilterRuleAdd(rule *opnsense.FilterRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleAdd indicates an expected call of FirewallFilterRuleAdd.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleAdd", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleAdd), rule)
+}
+
+// FirewallFilterRuleAddCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleAddCtx(ctx context.Context, rule *opnsense.FilterRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleAddCtx indicates an expected call of FirewallFilterRuleAddCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleAddCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleAddCtx), ctx, rule)
+}
+
+// FirewallFilterRuleDelete mocks base method.
+func (m *MockAPI) FirewallFilterRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleDelete indicates an expected call of FirewallFilterRuleDelete.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDelete", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleDelete), arg0)
+}
+
+// FirewallFilterRuleDeleteByCategory mocks base method.
+func (m *MockAPI) FirewallFilterRuleDeleteByCategory(category uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDeleteByCategory", category)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleDeleteByCategory indicates an expected call of FirewallFilterRuleDeleteByCategory.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleDeleteByCategory(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDeleteByCategory", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleDeleteByCategory), category)
+}
+
+// FirewallFilterRuleDeleteByCategoryCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleDeleteByCategoryCtx(ctx context.Context, category uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDeleteByCategoryCtx", ctx, category)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleDeleteByCategoryCtx indicates an expected call of FirewallFilterRuleDeleteByCategoryCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleDeleteByCategoryCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDeleteByCategoryCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleDeleteByCategoryCtx), ctx, category)
+}
+
+// FirewallFilterRuleDeleteCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleDeleteCtx indicates an expected call of FirewallFilterRuleDeleteCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleDeleteCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallFilterRuleFindByCategory mocks base method.
+func (m *MockAPI) FirewallFilterRuleFindByCategory(category uuid.UUID) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByCategory", category)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByCategory indicates an expected call of FirewallFilterRuleFindByCategory.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleFindByCategory(category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByCategory", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleFindByCategory), category)
+}
+
+// FirewallFilterRuleFindByCategoryCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleFindByCategoryCtx(ctx context.Context, category uuid.UUID) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByCategoryCtx", ctx, category)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByCategoryCtx indicates an expected call of FirewallFilterRuleFindByCategoryCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleFindByCategoryCtx(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByCategoryCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleFindByCategoryCtx), ctx, category)
+}
+
+// FirewallFilterRuleFindByDescription mocks base method.
+func (m *MockAPI) FirewallFilterRuleFindByDescription(description string) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByDescription", description)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByDescription indicates an expected call of FirewallFilterRuleFindByDescription.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleFindByDescription(description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByDescription", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleFindByDescription), description)
+}
+
+// FirewallFilterRuleFindByDescriptionCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleFindByDescriptionCtx(ctx context.Context, description string) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleFindByDescriptionCtx", ctx, description)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleFindByDescriptionCtx indicates an expected call of FirewallFilterRuleFindByDescriptionCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleFindByDescriptionCtx(ctx, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleFindByDescriptionCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleFindByDescriptionCtx), ctx, description)
+}
+
+// FirewallFilterRuleGet mocks base method.
+func (m *MockAPI) FirewallFilterRuleGet(arg0 uuid.UUID) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleGet indicates an expected call of FirewallFilterRuleGet.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleGet", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleGet), arg0)
+}
+
+// FirewallFilterRuleGetCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleGetCtx indicates an expected call of FirewallFilterRuleGetCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleGetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleGetCtx), ctx, arg1)
+}
+
+// FirewallFilterRuleMoveBefore mocks base method.
+func (m *MockAPI) FirewallFilterRuleMoveBefore(id, anchor uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleMoveBefore", id, anchor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleMoveBefore indicates an expected call of FirewallFilterRuleMoveBefore.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleMoveBefore(id, anchor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleMoveBefore", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleMoveBefore), id, anchor)
+}
+
+// FirewallFilterRuleMoveBeforeCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleMoveBeforeCtx(ctx context.Context, id, anchor uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleMoveBeforeCtx", ctx, id, anchor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleMoveBeforeCtx indicates an expected call of FirewallFilterRuleMoveBeforeCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleMoveBeforeCtx(ctx, id, anchor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleMoveBeforeCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleMoveBeforeCtx), ctx, id, anchor)
+}
+
+// FirewallFilterRulePlan mocks base method.
+func (m *MockAPI) FirewallFilterRulePlan(scope opnsense.FilterRuleScope, desired []opnsense.FilterRule) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlan", scope, desired)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRulePlan indicates an expected call of FirewallFilterRulePlan.
+func (mr *MockAPIMockRecorder) FirewallFilterRulePlan(scope, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlan", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRulePlan), scope, desired)
+}
+
+// FirewallFilterRulePlanApply mocks base method.
+func (m *MockAPI) FirewallFilterRulePlanApply(plan *opnsense.FilterRulePlan, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlanApply", plan, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulePlanApply indicates an expected call of FirewallFilterRulePlanApply.
+func (mr *MockAPIMockRecorder) FirewallFilterRulePlanApply(plan, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlanApply", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRulePlanApply), plan, check)
+}
+
+// FirewallFilterRulePlanApplyCtx mocks base method.
+func (m *MockAPI) FirewallFilterRulePlanApplyCtx(ctx context.Context, plan *opnsense.FilterRulePlan, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlanApplyCtx", ctx, plan, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulePlanApplyCtx indicates an expected call of FirewallFilterRulePlanApplyCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRulePlanApplyCtx(ctx, plan, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlanApplyCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRulePlanApplyCtx), ctx, plan, check)
+}
+
+// FirewallFilterRulePlanCtx mocks base method.
+func (m *MockAPI) FirewallFilterRulePlanCtx(ctx context.Context, scope opnsense.FilterRuleScope, desired []opnsense.FilterRule) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulePlanCtx", ctx, scope, desired)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRulePlanCtx indicates an expected call of FirewallFilterRulePlanCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRulePlanCtx(ctx, scope, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulePlanCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRulePlanCtx), ctx, scope, desired)
+}
+
+// FirewallFilterRuleReconcile mocks base method.
+func (m *MockAPI) FirewallFilterRuleReconcile(scope opnsense.FilterRuleScope, desired []opnsense.FilterRule, check opnsense.FirewallFilterCheck) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleReconcile", scope, desired, check)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleReconcile indicates an expected call of FirewallFilterRuleReconcile.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleReconcile(scope, desired, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleReconcile", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleReconcile), scope, desired, check)
+}
+
+// FirewallFilterRuleReconcileCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleReconcileCtx(ctx context.Context, scope opnsense.FilterRuleScope, desired []opnsense.FilterRule, check opnsense.FirewallFilterCheck) (*opnsense.FilterRulePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleReconcileCtx", ctx, scope, desired, check)
+	ret0, _ := ret[0].(*opnsense.FilterRulePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleReconcileCtx indicates an expected call of FirewallFilterRuleReconcileCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleReconcileCtx(ctx, scope, desired, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleReconcileCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleReconcileCtx), ctx, scope, desired, check)
+}
+
+// FirewallFilterRuleSearch mocks base method.
+func (m *MockAPI) FirewallFilterRuleSearch() ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleSearch indicates an expected call of FirewallFilterRuleSearch.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSearch", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleSearch))
+}
+
+// FirewallFilterRuleSearchAll mocks base method.
+func (m *MockAPI) FirewallFilterRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.FilterRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.FilterRule, error])
+	return ret0
+}
+
+// FirewallFilterRuleSearchAll indicates an expected call of FirewallFilterRuleSearchAll.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSearchAll", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleSearchAll), ctx, opts)
+}
+
+// FirewallFilterRuleSearchCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleSearchCtx(ctx context.Context) ([]*opnsense.FilterRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.FilterRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleSearchCtx indicates an expected call of FirewallFilterRuleSearchCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSearchCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleSearchCtx), ctx)
+}
+
+// FirewallFilterRuleSet mocks base method.
+func (m *MockAPI) FirewallFilterRuleSet(rule *opnsense.FilterRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleSet indicates an expected call of FirewallFilterRuleSet.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSet", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleSet), rule)
+}
+
+// FirewallFilterRuleSetCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleSetCtx(ctx context.Context, rule *opnsense.FilterRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRuleSetCtx indicates an expected call of FirewallFilterRuleSetCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleSetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleSetCtx), ctx, rule)
+}
+
+// FirewallFilterRuleToggle mocks base method.
+func (m *MockAPI) FirewallFilterRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleToggle indicates an expected call of FirewallFilterRuleToggle.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleToggle", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleToggle), arg0, enabled)
+}
+
+// FirewallFilterRuleToggleCtx mocks base method.
+func (m *MockAPI) FirewallFilterRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterRuleToggleCtx indicates an expected call of FirewallFilterRuleToggleCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRuleToggleCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallFilterRulesReorder mocks base method.
+func (m *MockAPI) FirewallFilterRulesReorder(order []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulesReorder", order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulesReorder indicates an expected call of FirewallFilterRulesReorder.
+func (mr *MockAPIMockRecorder) FirewallFilterRulesReorder(order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulesReorder", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRulesReorder), order)
+}
+
+// FirewallFilterRulesReorderCtx mocks base method.
+func (m *MockAPI) FirewallFilterRulesReorderCtx(ctx context.Context, order []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterRulesReorderCtx", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterRulesReorderCtx indicates an expected call of FirewallFilterRulesReorderCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterRulesReorderCtx(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterRulesReorderCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterRulesReorderCtx), ctx, order)
+}
+
+// FirewallFilterSavepoint mocks base method.
+func (m *MockAPI) FirewallFilterSavepoint() (*opnsense.Savepoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterSavepoint")
+	ret0, _ := ret[0].(*opnsense.Savepoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterSavepoint indicates an expected call of FirewallFilterSavepoint.
+func (mr *MockAPIMockRecorder) FirewallFilterSavepoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterSavepoint", reflect.TypeOf((*MockAPI)(nil).FirewallFilterSavepoint))
+}
+
+// FirewallFilterSavepointCtx mocks base method.
+func (m *MockAPI) FirewallFilterSavepointCtx(ctx context.Context) (*opnsense.Savepoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterSavepointCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.Savepoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallFilterSavepointCtx indicates an expected call of FirewallFilterSavepointCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterSavepointCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterSavepointCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterSavepointCtx), ctx)
+}
+
+// FirewallFilterTransaction mocks base method.
+func (m *MockAPI) FirewallFilterTransaction(stage func(context.Context) error, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterTransaction", stage, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterTransaction indicates an expected call of FirewallFilterTransaction.
+func (mr *MockAPIMockRecorder) FirewallFilterTransaction(stage, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterTransaction", reflect.TypeOf((*MockAPI)(nil).FirewallFilterTransaction), stage, check)
+}
+
+// FirewallFilterTransactionCtx mocks base method.
+func (m *MockAPI) FirewallFilterTransactionCtx(ctx context.Context, stage func(context.Context) error, check opnsense.FirewallFilterCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallFilterTransactionCtx", ctx, stage, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallFilterTransactionCtx indicates an expected call of FirewallFilterTransactionCtx.
+func (mr *MockAPIMockRecorder) FirewallFilterTransactionCtx(ctx, stage, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallFilterTransactionCtx", reflect.TypeOf((*MockAPI)(nil).FirewallFilterTransactionCtx), ctx, stage, check)
+}
+
+// FirewallNptRuleAdd mocks base method.
+func (m *MockAPI) FirewallNptRuleAdd(rule *opnsense.NptRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleAdd indicates an expected call of FirewallNptRuleAdd.
+func (mr *MockAPIMockRecorder) FirewallNptRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleAdd", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleAdd), rule)
+}
+
+// FirewallNptRuleAddCtx mocks base method.
+func (m *MockAPI) FirewallNptRuleAddCtx(ctx context.Context, rule *opnsense.NptRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleAddCtx indicates an expected call of FirewallNptRuleAddCtx.
+func (mr *MockAPIMockRecorder) FirewallNptRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleAddCtx", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleAddCtx), ctx, rule)
+}
+
+// FirewallNptRuleDelete mocks base method.
+func (m *MockAPI) FirewallNptRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleDelete indicates an expected call of FirewallNptRuleDelete.
+func (mr *MockAPIMockRecorder) FirewallNptRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleDelete", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleDelete), arg0)
+}
+
+// FirewallNptRuleDeleteCtx mocks base method.
+func (m *MockAPI) FirewallNptRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleDeleteCtx indicates an expected call of FirewallNptRuleDeleteCtx.
+func (mr *MockAPIMockRecorder) FirewallNptRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleDeleteCtx", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallNptRuleGet mocks base method.
+func (m *MockAPI) FirewallNptRuleGet(arg0 uuid.UUID) (*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleGet indicates an expected call of FirewallNptRuleGet.
+func (mr *MockAPIMockRecorder) FirewallNptRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleGet", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleGet), arg0)
+}
+
+// FirewallNptRuleGetCtx mocks base method.
+func (m *MockAPI) FirewallNptRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleGetCtx indicates an expected call of FirewallNptRuleGetCtx.
+func (mr *MockAPIMockRecorder) FirewallNptRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleGetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleGetCtx), ctx, arg1)
+}
+
+// FirewallNptRuleSearch mocks base method.
+func (m *MockAPI) FirewallNptRuleSearch() ([]*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleSearch indicates an expected call of FirewallNptRuleSearch.
+func (mr *MockAPIMockRecorder) FirewallNptRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSearch", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleSearch))
+}
+
+// FirewallNptRuleSearchAll mocks base method.
+func (m *MockAPI) FirewallNptRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.NptRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.NptRule, error])
+	return ret0
+}
+
+// FirewallNptRuleSearchAll indicates an expected call of FirewallNptRuleSearchAll.
+func (mr *MockAPIMockRecorder) FirewallNptRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSearchAll", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleSearchAll), ctx, opts)
+}
+
+// FirewallNptRuleSearchCtx mocks base method.
+func (m *MockAPI) FirewallNptRuleSearchCtx(ctx context.Context) ([]*opnsense.NptRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.NptRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleSearchCtx indicates an expected call of FirewallNptRuleSearchCtx.
+func (mr *MockAPIMockRecorder) FirewallNptRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSearchCtx", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleSearchCtx), ctx)
+}
+
+// FirewallNptRuleSet mocks base method.
+func (m *MockAPI) FirewallNptRuleSet(rule *opnsense.NptRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleSet indicates an expected call of FirewallNptRuleSet.
+func (mr *MockAPIMockRecorder) FirewallNptRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSet", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleSet), rule)
+}
+
+// FirewallNptRuleSetCtx mocks base method.
+func (m *MockAPI) FirewallNptRuleSetCtx(ctx context.Context, rule *opnsense.NptRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallNptRuleSetCtx indicates an expected call of FirewallNptRuleSetCtx.
+func (mr *MockAPIMockRecorder) FirewallNptRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleSetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleSetCtx), ctx, rule)
+}
+
+// FirewallNptRuleToggle mocks base method.
+func (m *MockAPI) FirewallNptRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleToggle indicates an expected call of FirewallNptRuleToggle.
+func (mr *MockAPIMockRecorder) FirewallNptRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleToggle", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleToggle), arg0, enabled)
+}
+
+// FirewallNptRuleToggleCtx mocks base method.
+func (m *MockAPI) FirewallNptRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallNptRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallNptRuleToggleCtx indicates an expected call of FirewallNptRuleToggleCtx.
+func (mr *MockAPIMockRecorder) FirewallNptRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallNptRuleToggleCtx", reflect.TypeOf((*MockAPI)(nil).FirewallNptRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallOneToOneRuleAdd mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleAdd(rule *opnsense.OneToOneRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleAdd indicates an expected call of FirewallOneToOneRuleAdd.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleAdd", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleAdd), rule)
+}
+
+// FirewallOneToOneRuleAddCtx mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleAddCtx(ctx context.Context, rule *opnsense.OneToOneRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleAddCtx indicates an expected call of FirewallOneToOneRuleAddCtx.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleAddCtx", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleAddCtx), ctx, rule)
+}
+
+// FirewallOneToOneRuleDelete mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleDelete indicates an expected call of FirewallOneToOneRuleDelete.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleDelete", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleDelete), arg0)
+}
+
+// FirewallOneToOneRuleDeleteCtx mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleDeleteCtx indicates an expected call of FirewallOneToOneRuleDeleteCtx.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleDeleteCtx", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallOneToOneRuleGet mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleGet(arg0 uuid.UUID) (*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleGet indicates an expected call of FirewallOneToOneRuleGet.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleGet", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleGet), arg0)
+}
+
+// FirewallOneToOneRuleGetCtx mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleGetCtx indicates an expected call of FirewallOneToOneRuleGetCtx.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleGetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleGetCtx), ctx, arg1)
+}
+
+// FirewallOneToOneRuleSearch mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleSearch() ([]*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleSearch indicates an expected call of FirewallOneToOneRuleSearch.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSearch", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleSearch))
+}
+
+// FirewallOneToOneRuleSearchAll mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.OneToOneRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.OneToOneRule, error])
+	return ret0
+}
+
+// FirewallOneToOneRuleSearchAll indicates an expected call of FirewallOneToOneRuleSearchAll.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSearchAll", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleSearchAll), ctx, opts)
+}
+
+// FirewallOneToOneRuleSearchCtx mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleSearchCtx(ctx context.Context) ([]*opnsense.OneToOneRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.OneToOneRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleSearchCtx indicates an expected call of FirewallOneToOneRuleSearchCtx.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSearchCtx", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleSearchCtx), ctx)
+}
+
+// FirewallOneToOneRuleSet mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleSet(rule *opnsense.OneToOneRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleSet indicates an expected call of FirewallOneToOneRuleSet.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSet", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleSet), rule)
+}
+
+// FirewallOneToOneRuleSetCtx mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleSetCtx(ctx context.Context, rule *opnsense.OneToOneRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallOneToOneRuleSetCtx indicates an expected call of FirewallOneToOneRuleSetCtx.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleSetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleSetCtx), ctx, rule)
+}
+
+// FirewallOneToOneRuleToggle mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleToggle indicates an expected call of FirewallOneToOneRuleToggle.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleToggle", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleToggle), arg0, enabled)
+}
+
+// FirewallOneToOneRuleToggleCtx mocks base method.
+func (m *MockAPI) FirewallOneToOneRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallOneToOneRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallOneToOneRuleToggleCtx indicates an expected call of FirewallOneToOneRuleToggleCtx.
+func (mr *MockAPIMockRecorder) FirewallOneToOneRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallOneToOneRuleToggleCtx", reflect.TypeOf((*MockAPI)(nil).FirewallOneToOneRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirewallSourceNatRuleAdd mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleAdd(rule *opnsense.SourceNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleAdd", rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleAdd indicates an expected call of FirewallSourceNatRuleAdd.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleAdd(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleAdd", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleAdd), rule)
+}
+
+// FirewallSourceNatRuleAddCtx mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleAddCtx(ctx context.Context, rule *opnsense.SourceNatRule) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleAddCtx", ctx, rule)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleAddCtx indicates an expected call of FirewallSourceNatRuleAddCtx.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleAddCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleAddCtx", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleAddCtx), ctx, rule)
+}
+
+// FirewallSourceNatRuleDelete mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleDelete indicates an expected call of FirewallSourceNatRuleDelete.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleDelete", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleDelete), arg0)
+}
+
+// FirewallSourceNatRuleDeleteCtx mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleDeleteCtx indicates an expected call of FirewallSourceNatRuleDeleteCtx.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleDeleteCtx", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleDeleteCtx), ctx, arg1)
+}
+
+// FirewallSourceNatRuleGet mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleGet(arg0 uuid.UUID) (*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleGet", arg0)
+	ret0, _ := ret[0].(*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleGet indicates an expected call of FirewallSourceNatRuleGet.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleGet", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleGet), arg0)
+}
+
+// FirewallSourceNatRuleGetCtx mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleGetCtx indicates an expected call of FirewallSourceNatRuleGetCtx.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleGetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleGetCtx), ctx, arg1)
+}
+
+// FirewallSourceNatRuleSearch mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleSearch() ([]*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSearch")
+	ret0, _ := ret[0].([]*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleSearch indicates an expected call of FirewallSourceNatRuleSearch.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSearch", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleSearch))
+}
+
+// FirewallSourceNatRuleSearchAll mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.SourceNatRule, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.SourceNatRule, error])
+	return ret0
+}
+
+// FirewallSourceNatRuleSearchAll indicates an expected call of FirewallSourceNatRuleSearchAll.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSearchAll", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleSearchAll), ctx, opts)
+}
+
+// FirewallSourceNatRuleSearchCtx mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleSearchCtx(ctx context.Context) ([]*opnsense.SourceNatRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.SourceNatRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleSearchCtx indicates an expected call of FirewallSourceNatRuleSearchCtx.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSearchCtx", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleSearchCtx), ctx)
+}
+
+// FirewallSourceNatRuleSet mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleSet(rule *opnsense.SourceNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSet", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleSet indicates an expected call of FirewallSourceNatRuleSet.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleSet(rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSet", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleSet), rule)
+}
+
+// FirewallSourceNatRuleSetCtx mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleSetCtx(ctx context.Context, rule *opnsense.SourceNatRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleSetCtx", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirewallSourceNatRuleSetCtx indicates an expected call of FirewallSourceNatRuleSetCtx.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleSetCtx(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleSetCtx", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleSetCtx), ctx, rule)
+}
+
+// FirewallSourceNatRuleToggle mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleToggle(arg0 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleToggle", arg0, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleToggle indicates an expected call of FirewallSourceNatRuleToggle.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleToggle(arg0, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleToggle", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleToggle), arg0, enabled)
+}
+
+// FirewallSourceNatRuleToggleCtx mocks base method.
+func (m *MockAPI) FirewallSourceNatRuleToggleCtx(ctx context.Context, arg1 uuid.UUID, enabled opnsense.Bool) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallSourceNatRuleToggleCtx", ctx, arg1, enabled)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallSourceNatRuleToggleCtx indicates an expected call of FirewallSourceNatRuleToggleCtx.
+func (mr *MockAPIMockRecorder) FirewallSourceNatRuleToggleCtx(ctx, arg1, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallSourceNatRuleToggleCtx", reflect.TypeOf((*MockAPI)(nil).FirewallSourceNatRuleToggleCtx), ctx, arg1, enabled)
+}
+
+// FirmwareConfigGet mocks base method.
+func (m *MockAPI) FirmwareConfigGet() (*opnsense.FirmwareConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigGet")
+	ret0, _ := ret[0].(*opnsense.FirmwareConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigGet indicates an expected call of FirmwareConfigGet.
+func (mr *MockAPIMockRecorder) FirmwareConfigGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigGet", reflect.TypeOf((*MockAPI)(nil).FirmwareConfigGet))
+}
+
+// FirmwareConfigGetCtx mocks base method.
+func (m *MockAPI) FirmwareConfigGetCtx(ctx context.Context) (*opnsense.FirmwareConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigGetCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.FirmwareConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigGetCtx indicates an expected call of FirmwareConfigGetCtx.
+func (mr *MockAPIMockRecorder) FirmwareConfigGetCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigGetCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareConfigGetCtx), ctx)
+}
+
+// FirmwareConfigSet mocks base method.
+func (m *MockAPI) FirmwareConfigSet(config opnsense.FirmwareConfig) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigSet", config)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigSet indicates an expected call of FirmwareConfigSet.
+func (mr *MockAPIMockRecorder) FirmwareConfigSet(config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigSet", reflect.TypeOf((*MockAPI)(nil).FirmwareConfigSet), config)
+}
+
+// FirmwareConfigSetCtx mocks base method.
+func (m *MockAPI) FirmwareConfigSetCtx(ctx context.Context, config opnsense.FirmwareConfig) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareConfigSetCtx", ctx, config)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareConfigSetCtx indicates an expected call of FirmwareConfigSetCtx.
+func (mr *MockAPIMockRecorder) FirmwareConfigSetCtx(ctx, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareConfigSetCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareConfigSetCtx), ctx, config)
+}
+
+// FirmwareDetails mocks base method.
+func (m *MockAPI) FirmwareDetails(packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareDetails", packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareDetails indicates an expected call of FirmwareDetails.
+func (mr *MockAPIMockRecorder) FirmwareDetails(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareDetails", reflect.TypeOf((*MockAPI)(nil).FirmwareDetails), packageName)
+}
+
+// FirmwareDetailsCtx mocks base method.
+func (m *MockAPI) FirmwareDetailsCtx(ctx context.Context, packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareDetailsCtx", ctx, packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareDetailsCtx indicates an expected call of FirmwareDetailsCtx.
+func (mr *MockAPIMockRecorder) FirmwareDetailsCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareDetailsCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareDetailsCtx), ctx, packageName)
+}
+
+// FirmwareInformation mocks base method.
+func (m *MockAPI) FirmwareInformation() (*opnsense.Information, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInformation")
+	ret0, _ := ret[0].(*opnsense.Information)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInformation indicates an expected call of FirmwareInformation.
+func (mr *MockAPIMockRecorder) FirmwareInformation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInformation", reflect.TypeOf((*MockAPI)(nil).FirmwareInformation))
+}
+
+// FirmwareInformationCtx mocks base method.
+func (m *MockAPI) FirmwareInformationCtx(ctx context.Context) (*opnsense.Information, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInformationCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.Information)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInformationCtx indicates an expected call of FirmwareInformationCtx.
+func (mr *MockAPIMockRecorder) FirmwareInformationCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInformationCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareInformationCtx), ctx)
+}
+
+// FirmwareInstall mocks base method.
+func (m *MockAPI) FirmwareInstall(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstall", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareInstall indicates an expected call of FirmwareInstall.
+func (mr *MockAPIMockRecorder) FirmwareInstall(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstall", reflect.TypeOf((*MockAPI)(nil).FirmwareInstall), packageName)
+}
+
+// FirmwareInstallCtx mocks base method.
+func (m *MockAPI) FirmwareInstallCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstallCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareInstallCtx indicates an expected call of FirmwareInstallCtx.
+func (mr *MockAPIMockRecorder) FirmwareInstallCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstallCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareInstallCtx), ctx, packageName)
+}
+
+// FirmwareInstalledPluginsList mocks base method.
+func (m *MockAPI) FirmwareInstalledPluginsList() ([]opnsense.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstalledPluginsList")
+	ret0, _ := ret[0].([]opnsense.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInstalledPluginsList indicates an expected call of FirmwareInstalledPluginsList.
+func (mr *MockAPIMockRecorder) FirmwareInstalledPluginsList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstalledPluginsList", reflect.TypeOf((*MockAPI)(nil).FirmwareInstalledPluginsList))
+}
+
+// FirmwareInstalledPluginsListCtx mocks base method.
+func (m *MockAPI) FirmwareInstalledPluginsListCtx(ctx context.Context) ([]opnsense.Package, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareInstalledPluginsListCtx", ctx)
+	ret0, _ := ret[0].([]opnsense.Package)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareInstalledPluginsListCtx indicates an expected call of FirmwareInstalledPluginsListCtx.
+func (mr *MockAPIMockRecorder) FirmwareInstalledPluginsListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareInstalledPluginsListCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareInstalledPluginsListCtx), ctx)
+}
+
+// FirmwareLicense mocks base method.
+func (m *MockAPI) FirmwareLicense(packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLicense", packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareLicense indicates an expected call of FirmwareLicense.
+func (mr *MockAPIMockRecorder) FirmwareLicense(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLicense", reflect.TypeOf((*MockAPI)(nil).FirmwareLicense), packageName)
+}
+
+// FirmwareLicenseCtx mocks base method.
+func (m *MockAPI) FirmwareLicenseCtx(ctx context.Context, packageName string) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLicenseCtx", ctx, packageName)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareLicenseCtx indicates an expected call of FirmwareLicenseCtx.
+func (mr *MockAPIMockRecorder) FirmwareLicenseCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLicenseCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareLicenseCtx), ctx, packageName)
+}
+
+// FirmwareLock mocks base method.
+func (m *MockAPI) FirmwareLock(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLock", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareLock indicates an expected call of FirmwareLock.
+func (mr *MockAPIMockRecorder) FirmwareLock(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLock", reflect.TypeOf((*MockAPI)(nil).FirmwareLock), packageName)
+}
+
+// FirmwareLockCtx mocks base method.
+func (m *MockAPI) FirmwareLockCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareLockCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareLockCtx indicates an expected call of FirmwareLockCtx.
+func (mr *MockAPIMockRecorder) FirmwareLockCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareLockCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareLockCtx), ctx, packageName)
+}
+
+// FirmwareOptionsGet mocks base method.
+func (m *MockAPI) FirmwareOptionsGet() (*opnsense.FirmwareOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareOptionsGet")
+	ret0, _ := ret[0].(*opnsense.FirmwareOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareOptionsGet indicates an expected call of FirmwareOptionsGet.
+func (mr *MockAPIMockRecorder) FirmwareOptionsGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareOptionsGet", reflect.TypeOf((*MockAPI)(nil).FirmwareOptionsGet))
+}
+
+// FirmwareOptionsGetCtx mocks base method.
+func (m *MockAPI) FirmwareOptionsGetCtx(ctx context.Context) (*opnsense.FirmwareOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareOptionsGetCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.FirmwareOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareOptionsGetCtx indicates an expected call of FirmwareOptionsGetCtx.
+func (mr *MockAPIMockRecorder) FirmwareOptionsGetCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareOptionsGetCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareOptionsGetCtx), ctx)
+}
+
+// FirmwareReInstall mocks base method.
+func (m *MockAPI) FirmwareReInstall(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareReInstall", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareReInstall indicates an expected call of FirmwareReInstall.
+func (mr *MockAPIMockRecorder) FirmwareReInstall(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareReInstall", reflect.TypeOf((*MockAPI)(nil).FirmwareReInstall), packageName)
+}
+
+// FirmwareReInstallCtx mocks base method.
+func (m *MockAPI) FirmwareReInstallCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareReInstallCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareReInstallCtx indicates an expected call of FirmwareReInstallCtx.
+func (mr *MockAPIMockRecorder) FirmwareReInstallCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareReInstallCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareReInstallCtx), ctx, packageName)
+}
+
+// FirmwareRemove mocks base method.
+func (m *MockAPI) FirmwareRemove(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareRemove", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareRemove indicates an expected call of FirmwareRemove.
+func (mr *MockAPIMockRecorder) FirmwareRemove(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareRemove", reflect.TypeOf((*MockAPI)(nil).FirmwareRemove), packageName)
+}
+
+// FirmwareRemoveCtx mocks base method.
+func (m *MockAPI) FirmwareRemoveCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareRemoveCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareRemoveCtx indicates an expected call of FirmwareRemoveCtx.
+func (mr *MockAPIMockRecorder) FirmwareRemoveCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareRemoveCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareRemoveCtx), ctx, packageName)
+}
+
+// FirmwareStatus mocks base method.
+func (m *MockAPI) FirmwareStatus() (*opnsense.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareStatus")
+	ret0, _ := ret[0].(*opnsense.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareStatus indicates an expected call of FirmwareStatus.
+func (mr *MockAPIMockRecorder) FirmwareStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareStatus", reflect.TypeOf((*MockAPI)(nil).FirmwareStatus))
+}
+
+// FirmwareStatusCtx mocks base method.
+func (m *MockAPI) FirmwareStatusCtx(ctx context.Context) (*opnsense.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareStatusCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareStatusCtx indicates an expected call of FirmwareStatusCtx.
+func (mr *MockAPIMockRecorder) FirmwareStatusCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareStatusCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareStatusCtx), ctx)
+}
+
+// FirmwareUnlock mocks base method.
+func (m *MockAPI) FirmwareUnlock(packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUnlock", packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareUnlock indicates an expected call of FirmwareUnlock.
+func (mr *MockAPIMockRecorder) FirmwareUnlock(packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUnlock", reflect.TypeOf((*MockAPI)(nil).FirmwareUnlock), packageName)
+}
+
+// FirmwareUnlockCtx mocks base method.
+func (m *MockAPI) FirmwareUnlockCtx(ctx context.Context, packageName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUnlockCtx", ctx, packageName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FirmwareUnlockCtx indicates an expected call of FirmwareUnlockCtx.
+func (mr *MockAPIMockRecorder) FirmwareUnlockCtx(ctx, packageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUnlockCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareUnlockCtx), ctx, packageName)
+}
+
+// FirmwareUpgradeStatus mocks base method.
+func (m *MockAPI) FirmwareUpgradeStatus() (*opnsense.UpgradeStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUpgradeStatus")
+	ret0, _ := ret[0].(*opnsense.UpgradeStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareUpgradeStatus indicates an expected call of FirmwareUpgradeStatus.
+func (mr *MockAPIMockRecorder) FirmwareUpgradeStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUpgradeStatus", reflect.TypeOf((*MockAPI)(nil).FirmwareUpgradeStatus))
+}
+
+// FirmwareUpgradeStatusCtx mocks base method.
+func (m *MockAPI) FirmwareUpgradeStatusCtx(ctx context.Context) (*opnsense.UpgradeStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirmwareUpgradeStatusCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.UpgradeStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirmwareUpgradeStatusCtx indicates an expected call of FirmwareUpgradeStatusCtx.
+func (mr *MockAPIMockRecorder) FirmwareUpgradeStatusCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirmwareUpgradeStatusCtx", reflect.TypeOf((*MockAPI)(nil).FirmwareUpgradeStatusCtx), ctx)
+}
+
+// InterfaceGroupAdd mocks base method.
+func (m *MockAPI) InterfaceGroupAdd(group *opnsense.InterfaceGroup) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupAdd", group)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupAdd indicates an expected call of InterfaceGroupAdd.
+func (mr *MockAPIMockRecorder) InterfaceGroupAdd(group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupAdd", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupAdd), group)
+}
+
+// InterfaceGroupAddCtx mocks base method.
+func (m *MockAPI) InterfaceGroupAddCtx(ctx context.Context, group *opnsense.InterfaceGroup) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupAddCtx", ctx, group)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupAddCtx indicates an expected call of InterfaceGroupAddCtx.
+func (mr *MockAPIMockRecorder) InterfaceGroupAddCtx(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupAddCtx", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupAddCtx), ctx, group)
+}
+
+// InterfaceGroupDelete mocks base method.
+func (m *MockAPI) InterfaceGroupDelete(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupDelete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupDelete indicates an expected call of InterfaceGroupDelete.
+func (mr *MockAPIMockRecorder) InterfaceGroupDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupDelete", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupDelete), arg0)
+}
+
+// InterfaceGroupDeleteCtx mocks base method.
+func (m *MockAPI) InterfaceGroupDeleteCtx(ctx context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupDeleteCtx indicates an expected call of InterfaceGroupDeleteCtx.
+func (mr *MockAPIMockRecorder) InterfaceGroupDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupDeleteCtx", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupDeleteCtx), ctx, arg1)
+}
+
+// InterfaceGroupGet mocks base method.
+func (m *MockAPI) InterfaceGroupGet(arg0 uuid.UUID) (*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupGet", arg0)
+	ret0, _ := ret[0].(*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupGet indicates an expected call of InterfaceGroupGet.
+func (mr *MockAPIMockRecorder) InterfaceGroupGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupGet", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupGet), arg0)
+}
+
+// InterfaceGroupGetCtx mocks base method.
+func (m *MockAPI) InterfaceGroupGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupGetCtx indicates an expected call of InterfaceGroupGetCtx.
+func (mr *MockAPIMockRecorder) InterfaceGroupGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupGetCtx", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupGetCtx), ctx, arg1)
+}
+
+// InterfaceGroupReconfigure mocks base method.
+func (m *MockAPI) InterfaceGroupReconfigure() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupReconfigure")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupReconfigure indicates an expected call of InterfaceGroupReconfigure.
+func (mr *MockAPIMockRecorder) InterfaceGroupReconfigure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupReconfigure", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupReconfigure))
+}
+
+// InterfaceGroupReconfigureCtx mocks base method.
+func (m *MockAPI) InterfaceGroupReconfigureCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupReconfigureCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupReconfigureCtx indicates an expected call of InterfaceGroupReconfigureCtx.
+func (mr *MockAPIMockRecorder) InterfaceGroupReconfigureCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupReconfigureCtx", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupReconfigureCtx), ctx)
+}
+
+// InterfaceGroupSearch mocks base method.
+func (m *MockAPI) InterfaceGroupSearch() ([]*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSearch")
+	ret0, _ := ret[0].([]*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupSearch indicates an expected call of InterfaceGroupSearch.
+func (mr *MockAPIMockRecorder) InterfaceGroupSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSearch", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupSearch))
+}
+
+// InterfaceGroupSearchAll mocks base method.
+func (m *MockAPI) InterfaceGroupSearchAll(ctx context.Context, opts opnsense.SearchOptions) iter.Seq2[*opnsense.InterfaceGroup, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSearchAll", ctx, opts)
+	ret0, _ := ret[0].(iter.Seq2[*opnsense.InterfaceGroup, error])
+	return ret0
+}
+
+// InterfaceGroupSearchAll indicates an expected call of InterfaceGroupSearchAll.
+func (mr *MockAPIMockRecorder) InterfaceGroupSearchAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSearchAll", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupSearchAll), ctx, opts)
+}
+
+// InterfaceGroupSearchCtx mocks base method.
+func (m *MockAPI) InterfaceGroupSearchCtx(ctx context.Context) ([]*opnsense.InterfaceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSearchCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.InterfaceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfaceGroupSearchCtx indicates an expected call of InterfaceGroupSearchCtx.
+func (mr *MockAPIMockRecorder) InterfaceGroupSearchCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSearchCtx", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupSearchCtx), ctx)
+}
+
+// InterfaceGroupSet mocks base method.
+func (m *MockAPI) InterfaceGroupSet(group *opnsense.InterfaceGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSet", group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupSet indicates an expected call of InterfaceGroupSet.
+func (mr *MockAPIMockRecorder) InterfaceGroupSet(group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSet", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupSet), group)
+}
+
+// InterfaceGroupSetCtx mocks base method.
+func (m *MockAPI) InterfaceGroupSetCtx(ctx context.Context, group *opnsense.InterfaceGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfaceGroupSetCtx", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfaceGroupSetCtx indicates an expected call of InterfaceGroupSetCtx.
+func (mr *MockAPIMockRecorder) InterfaceGroupSetCtx(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfaceGroupSetCtx", reflect.TypeOf((*MockAPI)(nil).InterfaceGroupSetCtx), ctx, group)
+}
+
+// InterfacesList mocks base method.
+func (m *MockAPI) InterfacesList() ([]*opnsense.InterfaceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesList")
+	ret0, _ := ret[0].([]*opnsense.InterfaceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfacesList indicates an expected call of InterfacesList.
+func (mr *MockAPIMockRecorder) InterfacesList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesList", reflect.TypeOf((*MockAPI)(nil).InterfacesList))
+}
+
+// InterfacesListCtx mocks base method.
+func (m *MockAPI) InterfacesListCtx(ctx context.Context) ([]*opnsense.InterfaceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.InterfaceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InterfacesListCtx indicates an expected call of InterfacesListCtx.
+func (mr *MockAPIMockRecorder) InterfacesListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesListCtx", reflect.TypeOf((*MockAPI)(nil).InterfacesListCtx), ctx)
+}
+
+// InterfacesValidate mocks base method.
+func (m *MockAPI) InterfacesValidate(iface opnsense.Interface) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesValidate", iface)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfacesValidate indicates an expected call of InterfacesValidate.
+func (mr *MockAPIMockRecorder) InterfacesValidate(iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesValidate", reflect.TypeOf((*MockAPI)(nil).InterfacesValidate), iface)
+}
+
+// InterfacesValidateCtx mocks base method.
+func (m *MockAPI) InterfacesValidateCtx(ctx context.Context, iface opnsense.Interface) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InterfacesValidateCtx", ctx, iface)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InterfacesValidateCtx indicates an expected call of InterfacesValidateCtx.
+func (mr *MockAPIMockRecorder) InterfacesValidateCtx(ctx, iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InterfacesValidateCtx", reflect.TypeOf((*MockAPI)(nil).InterfacesValidateCtx), ctx, iface)
+}
+
+// PowerOff mocks base method.
+func (m *MockAPI) PowerOff() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOff")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PowerOff indicates an expected call of PowerOff.
+func (mr *MockAPIMockRecorder) PowerOff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOff", reflect.TypeOf((*MockAPI)(nil).PowerOff))
+}
+
+// PowerOffCtx mocks base method.
+func (m *MockAPI) PowerOffCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOffCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PowerOffCtx indicates an expected call of PowerOffCtx.
+func (mr *MockAPIMockRecorder) PowerOffCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOffCtx", reflect.TypeOf((*MockAPI)(nil).PowerOffCtx), ctx)
+}
+
+// Reboot mocks base method.
+func (m *MockAPI) Reboot() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockAPIMockRecorder) Reboot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockAPI)(nil).Reboot))
+}
+
+// RebootCtx mocks base method.
+func (m *MockAPI) RebootCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootCtx indicates an expected call of RebootCtx.
+func (mr *MockAPIMockRecorder) RebootCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootCtx", reflect.TypeOf((*MockAPI)(nil).RebootCtx), ctx)
+}
+
+// Upgrade mocks base method.
+func (m *MockAPI) Upgrade() (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade")
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockAPIMockRecorder) Upgrade() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockAPI)(nil).Upgrade))
+}
+
+// UpgradeCtx mocks base method.
+func (m *MockAPI) UpgradeCtx(ctx context.Context) (*opnsense.StatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.StatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeCtx indicates an expected call of UpgradeCtx.
+func (mr *MockAPIMockRecorder) UpgradeCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeCtx", reflect.TypeOf((*MockAPI)(nil).UpgradeCtx), ctx)
+}
+
+// UpgradeStatus mocks base method.
+func (m *MockAPI) UpgradeStatus() (*opnsense.UpgradeStatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeStatus")
+	ret0, _ := ret[0].(*opnsense.UpgradeStatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeStatus indicates an expected call of UpgradeStatus.
+func (mr *MockAPIMockRecorder) UpgradeStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeStatus", reflect.TypeOf((*MockAPI)(nil).UpgradeStatus))
+}
+
+// UpgradeStatusCtx mocks base method.
+func (m *MockAPI) UpgradeStatusCtx(ctx context.Context) (*opnsense.UpgradeStatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeStatusCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.UpgradeStatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeStatusCtx indicates an expected call of UpgradeStatusCtx.
+func (mr *MockAPIMockRecorder) UpgradeStatusCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeStatusCtx", reflect.TypeOf((*MockAPI)(nil).UpgradeStatusCtx), ctx)
+}
+
+// WireGuardClientAdd mocks base method.
+func (m *MockAPI) WireGuardClientAdd(clientConf opnsense.WireGuardClientSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientAdd", clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientAdd indicates an expected call of WireGuardClientAdd.
+func (mr *MockAPIMockRecorder) WireGuardClientAdd(clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientAdd", reflect.TypeOf((*MockAPI)(nil).WireGuardClientAdd), clientConf)
+}
+
+// WireGuardClientAddCtx mocks base method.
+func (m *MockAPI) WireGuardClientAddCtx(ctx context.Context, clientConf opnsense.WireGuardClientSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientAddCtx", ctx, clientConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientAddCtx indicates an expected call of WireGuardClientAddCtx.
+func (mr *MockAPIMockRecorder) WireGuardClientAddCtx(ctx, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientAddCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardClientAddCtx), ctx, clientConf)
+}
+
+// WireGuardClientDelete mocks base method.
+func (m *MockAPI) WireGuardClientDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientDelete indicates an expected call of WireGuardClientDelete.
+func (mr *MockAPIMockRecorder) WireGuardClientDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientDelete", reflect.TypeOf((*MockAPI)(nil).WireGuardClientDelete), arg0)
+}
+
+// WireGuardClientDeleteCtx mocks base method.
+func (m *MockAPI) WireGuardClientDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientDeleteCtx indicates an expected call of WireGuardClientDeleteCtx.
+func (mr *MockAPIMockRecorder) WireGuardClientDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientDeleteCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardClientDeleteCtx), ctx, arg1)
+}
+
+// WireGuardClientGet mocks base method.
+func (m *MockAPI) WireGuardClientGet(arg0 uuid.UUID) (*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGet", arg0)
+	ret0, _ := ret[0].(*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGet indicates an expected call of WireGuardClientGet.
+func (mr *MockAPIMockRecorder) WireGuardClientGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGet", reflect.TypeOf((*MockAPI)(nil).WireGuardClientGet), arg0)
+}
+
+// WireGuardClientGetCtx mocks base method.
+func (m *MockAPI) WireGuardClientGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGetCtx indicates an expected call of WireGuardClientGetCtx.
+func (mr *MockAPIMockRecorder) WireGuardClientGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGetCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardClientGetCtx), ctx, arg1)
+}
+
+// WireGuardClientGetUUIDs mocks base method.
+func (m *MockAPI) WireGuardClientGetUUIDs() ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGetUUIDs")
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGetUUIDs indicates an expected call of WireGuardClientGetUUIDs.
+func (mr *MockAPIMockRecorder) WireGuardClientGetUUIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGetUUIDs", reflect.TypeOf((*MockAPI)(nil).WireGuardClientGetUUIDs))
+}
+
+// WireGuardClientGetUUIDsCtx mocks base method.
+func (m *MockAPI) WireGuardClientGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientGetUUIDsCtx", ctx)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientGetUUIDsCtx indicates an expected call of WireGuardClientGetUUIDsCtx.
+func (mr *MockAPIMockRecorder) WireGuardClientGetUUIDsCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientGetUUIDsCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardClientGetUUIDsCtx), ctx)
+}
+
+// WireGuardClientList mocks base method.
+func (m *MockAPI) WireGuardClientList() ([]*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientList")
+	ret0, _ := ret[0].([]*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientList indicates an expected call of WireGuardClientList.
+func (mr *MockAPIMockRecorder) WireGuardClientList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientList", reflect.TypeOf((*MockAPI)(nil).WireGuardClientList))
+}
+
+// WireGuardClientListCtx mocks base method.
+func (m *MockAPI) WireGuardClientListCtx(ctx context.Context) ([]*opnsense.WireGuardClientGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.WireGuardClientGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientListCtx indicates an expected call of WireGuardClientListCtx.
+func (mr *MockAPIMockRecorder) WireGuardClientListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientListCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardClientListCtx), ctx)
+}
+
+// WireGuardClientSet mocks base method.
+func (m *MockAPI) WireGuardClientSet(arg0 uuid.UUID, clientConf opnsense.WireGuardClientSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientSet", arg0, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientSet indicates an expected call of WireGuardClientSet.
+func (mr *MockAPIMockRecorder) WireGuardClientSet(arg0, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientSet", reflect.TypeOf((*MockAPI)(nil).WireGuardClientSet), arg0, clientConf)
+}
+
+// WireGuardClientSetCtx mocks base method.
+func (m *MockAPI) WireGuardClientSetCtx(ctx context.Context, arg1 uuid.UUID, clientConf opnsense.WireGuardClientSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardClientSetCtx", ctx, arg1, clientConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardClientSetCtx indicates an expected call of WireGuardClientSetCtx.
+func (mr *MockAPIMockRecorder) WireGuardClientSetCtx(ctx, arg1, clientConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardClientSetCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardClientSetCtx), ctx, arg1, clientConf)
+}
+
+// WireGuardDisableService mocks base method.
+func (m *MockAPI) WireGuardDisableService() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardDisableService")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardDisableService indicates an expected call of WireGuardDisableService.
+func (mr *MockAPIMockRecorder) WireGuardDisableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardDisableService", reflect.TypeOf((*MockAPI)(nil).WireGuardDisableService))
+}
+
+// WireGuardDisableServiceCtx mocks base method.
+func (m *MockAPI) WireGuardDisableServiceCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardDisableServiceCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardDisableServiceCtx indicates an expected call of WireGuardDisableServiceCtx.
+func (mr *MockAPIMockRecorder) WireGuardDisableServiceCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardDisableServiceCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardDisableServiceCtx), ctx)
+}
+
+// WireGuardEnableService mocks base method.
+func (m *MockAPI) WireGuardEnableService() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardEnableService")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardEnableService indicates an expected call of WireGuardEnableService.
+func (mr *MockAPIMockRecorder) WireGuardEnableService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardEnableService", reflect.TypeOf((*MockAPI)(nil).WireGuardEnableService))
+}
+
+// WireGuardEnableServiceCtx mocks base method.
+func (m *MockAPI) WireGuardEnableServiceCtx(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardEnableServiceCtx", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WireGuardEnableServiceCtx indicates an expected call of WireGuardEnableServiceCtx.
+func (mr *MockAPIMockRecorder) WireGuardEnableServiceCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardEnableServiceCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardEnableServiceCtx), ctx)
+}
+
+// WireGuardRestart mocks base method.
+func (m *MockAPI) WireGuardRestart() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardRestart")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardRestart indicates an expected call of WireGuardRestart.
+func (mr *MockAPIMockRecorder) WireGuardRestart() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardRestart", reflect.TypeOf((*MockAPI)(nil).WireGuardRestart))
+}
+
+// WireGuardRestartCtx mocks base method.
+func (m *MockAPI) WireGuardRestartCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardRestartCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardRestartCtx indicates an expected call of WireGuardRestartCtx.
+func (mr *MockAPIMockRecorder) WireGuardRestartCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardRestartCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardRestartCtx), ctx)
+}
+
+// WireGuardServerAdd mocks base method.
+func (m *MockAPI) WireGuardServerAdd(serverConf opnsense.WireGuardServerSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerAdd", serverConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerAdd indicates an expected call of WireGuardServerAdd.
+func (mr *MockAPIMockRecorder) WireGuardServerAdd(serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerAdd", reflect.TypeOf((*MockAPI)(nil).WireGuardServerAdd), serverConf)
+}
+
+// WireGuardServerAddCtx mocks base method.
+func (m *MockAPI) WireGuardServerAddCtx(ctx context.Context, serverConf opnsense.WireGuardServerSet) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerAddCtx", ctx, serverConf)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerAddCtx indicates an expected call of WireGuardServerAddCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerAddCtx(ctx, serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerAddCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerAddCtx), ctx, serverConf)
+}
+
+// WireGuardServerDelete mocks base method.
+func (m *MockAPI) WireGuardServerDelete(arg0 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerDelete", arg0)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerDelete indicates an expected call of WireGuardServerDelete.
+func (mr *MockAPIMockRecorder) WireGuardServerDelete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerDelete", reflect.TypeOf((*MockAPI)(nil).WireGuardServerDelete), arg0)
+}
+
+// WireGuardServerDeleteCtx mocks base method.
+func (m *MockAPI) WireGuardServerDeleteCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerDeleteCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerDeleteCtx indicates an expected call of WireGuardServerDeleteCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerDeleteCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerDeleteCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerDeleteCtx), ctx, arg1)
+}
+
+// WireGuardServerFindUUIDByName mocks base method.
+func (m *MockAPI) WireGuardServerFindUUIDByName(name string) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerFindUUIDByName", name)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerFindUUIDByName indicates an expected call of WireGuardServerFindUUIDByName.
+func (mr *MockAPIMockRecorder) WireGuardServerFindUUIDByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerFindUUIDByName", reflect.TypeOf((*MockAPI)(nil).WireGuardServerFindUUIDByName), name)
+}
+
+// WireGuardServerFindUUIDByNameCtx mocks base method.
+func (m *MockAPI) WireGuardServerFindUUIDByNameCtx(ctx context.Context, name string) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerFindUUIDByNameCtx", ctx, name)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerFindUUIDByNameCtx indicates an expected call of WireGuardServerFindUUIDByNameCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerFindUUIDByNameCtx(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerFindUUIDByNameCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerFindUUIDByNameCtx), ctx, name)
+}
+
+// WireGuardServerGet mocks base method.
+func (m *MockAPI) WireGuardServerGet(arg0 uuid.UUID) (*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGet", arg0)
+	ret0, _ := ret[0].(*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGet indicates an expected call of WireGuardServerGet.
+func (mr *MockAPIMockRecorder) WireGuardServerGet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGet", reflect.TypeOf((*MockAPI)(nil).WireGuardServerGet), arg0)
+}
+
+// WireGuardServerGetCtx mocks base method.
+func (m *MockAPI) WireGuardServerGetCtx(ctx context.Context, arg1 uuid.UUID) (*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGetCtx", ctx, arg1)
+	ret0, _ := ret[0].(*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGetCtx indicates an expected call of WireGuardServerGetCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerGetCtx(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGetCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerGetCtx), ctx, arg1)
+}
+
+// WireGuardServerGetUUIDs mocks base method.
+func (m *MockAPI) WireGuardServerGetUUIDs() ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGetUUIDs")
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGetUUIDs indicates an expected call of WireGuardServerGetUUIDs.
+func (mr *MockAPIMockRecorder) WireGuardServerGetUUIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGetUUIDs", reflect.TypeOf((*MockAPI)(nil).WireGuardServerGetUUIDs))
+}
+
+// WireGuardServerGetUUIDsCtx mocks base method.
+func (m *MockAPI) WireGuardServerGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerGetUUIDsCtx", ctx)
+	ret0, _ := ret[0].([]*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerGetUUIDsCtx indicates an expected call of WireGuardServerGetUUIDsCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerGetUUIDsCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerGetUUIDsCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerGetUUIDsCtx), ctx)
+}
+
+// WireGuardServerList mocks base method.
+func (m *MockAPI) WireGuardServerList() ([]*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerList")
+	ret0, _ := ret[0].([]*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerList indicates an expected call of WireGuardServerList.
+func (mr *MockAPIMockRecorder) WireGuardServerList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerList", reflect.TypeOf((*MockAPI)(nil).WireGuardServerList))
+}
+
+// WireGuardServerListCtx mocks base method.
+func (m *MockAPI) WireGuardServerListCtx(ctx context.Context) ([]*opnsense.WireGuardServerGet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerListCtx", ctx)
+	ret0, _ := ret[0].([]*opnsense.WireGuardServerGet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerListCtx indicates an expected call of WireGuardServerListCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerListCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerListCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerListCtx), ctx)
+}
+
+// WireGuardServerSet mocks base method.
+func (m *MockAPI) WireGuardServerSet(arg0 uuid.UUID, serverConf opnsense.WireGuardServerSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerSet", arg0, serverConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerSet indicates an expected call of WireGuardServerSet.
+func (mr *MockAPIMockRecorder) WireGuardServerSet(arg0, serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerSet", reflect.TypeOf((*MockAPI)(nil).WireGuardServerSet), arg0, serverConf)
+}
+
+// WireGuardServerSetCtx mocks base method.
+func (m *MockAPI) WireGuardServerSetCtx(ctx context.Context, arg1 uuid.UUID, serverConf opnsense.WireGuardServerSet) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardServerSetCtx", ctx, arg1, serverConf)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardServerSetCtx indicates an expected call of WireGuardServerSetCtx.
+func (mr *MockAPIMockRecorder) WireGuardServerSetCtx(ctx, arg1, serverConf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardServerSetCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardServerSetCtx), ctx, arg1, serverConf)
+}
+
+// WireGuardSettingsGet mocks base method.
+func (m *MockAPI) WireGuardSettingsGet() (*opnsense.WireGuardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsGet")
+	ret0, _ := ret[0].(*opnsense.WireGuardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsGet indicates an expected call of WireGuardSettingsGet.
+func (mr *MockAPIMockRecorder) WireGuardSettingsGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsGet", reflect.TypeOf((*MockAPI)(nil).WireGuardSettingsGet))
+}
+
+// WireGuardSettingsGetCtx mocks base method.
+func (m *MockAPI) WireGuardSettingsGetCtx(ctx context.Context) (*opnsense.WireGuardSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsGetCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.WireGuardSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsGetCtx indicates an expected call of WireGuardSettingsGetCtx.
+func (mr *MockAPIMockRecorder) WireGuardSettingsGetCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsGetCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardSettingsGetCtx), ctx)
+}
+
+// WireGuardSettingsSet mocks base method.
+func (m *MockAPI) WireGuardSettingsSet(settings opnsense.WireGuardSettings) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsSet", settings)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsSet indicates an expected call of WireGuardSettingsSet.
+func (mr *MockAPIMockRecorder) WireGuardSettingsSet(settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsSet", reflect.TypeOf((*MockAPI)(nil).WireGuardSettingsSet), settings)
+}
+
+// WireGuardSettingsSetCtx mocks base method.
+func (m *MockAPI) WireGuardSettingsSetCtx(ctx context.Context, settings opnsense.WireGuardSettings) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardSettingsSetCtx", ctx, settings)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardSettingsSetCtx indicates an expected call of WireGuardSettingsSetCtx.
+func (mr *MockAPIMockRecorder) WireGuardSettingsSetCtx(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardSettingsSetCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardSettingsSetCtx), ctx, settings)
+}
+
+// WireGuardShowConfig mocks base method.
+func (m *MockAPI) WireGuardShowConfig() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowConfig")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowConfig indicates an expected call of WireGuardShowConfig.
+func (mr *MockAPIMockRecorder) WireGuardShowConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowConfig", reflect.TypeOf((*MockAPI)(nil).WireGuardShowConfig))
+}
+
+// WireGuardShowConfigCtx mocks base method.
+func (m *MockAPI) WireGuardShowConfigCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowConfigCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowConfigCtx indicates an expected call of WireGuardShowConfigCtx.
+func (mr *MockAPIMockRecorder) WireGuardShowConfigCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowConfigCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardShowConfigCtx), ctx)
+}
+
+// WireGuardShowHandshake mocks base method.
+func (m *MockAPI) WireGuardShowHandshake() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowHandshake")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowHandshake indicates an expected call of WireGuardShowHandshake.
+func (mr *MockAPIMockRecorder) WireGuardShowHandshake() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowHandshake", reflect.TypeOf((*MockAPI)(nil).WireGuardShowHandshake))
+}
+
+// WireGuardShowHandshakeCtx mocks base method.
+func (m *MockAPI) WireGuardShowHandshakeCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardShowHandshakeCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardShowHandshakeCtx indicates an expected call of WireGuardShowHandshakeCtx.
+func (mr *MockAPIMockRecorder) WireGuardShowHandshakeCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardShowHandshakeCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardShowHandshakeCtx), ctx)
+}
+
+// WireGuardStart mocks base method.
+func (m *MockAPI) WireGuardStart() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStart")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStart indicates an expected call of WireGuardStart.
+func (mr *MockAPIMockRecorder) WireGuardStart() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStart", reflect.TypeOf((*MockAPI)(nil).WireGuardStart))
+}
+
+// WireGuardStartCtx mocks base method.
+func (m *MockAPI) WireGuardStartCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStartCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStartCtx indicates an expected call of WireGuardStartCtx.
+func (mr *MockAPIMockRecorder) WireGuardStartCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStartCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardStartCtx), ctx)
+}
+
+// WireGuardStop mocks base method.
+func (m *MockAPI) WireGuardStop() (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStop")
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStop indicates an expected call of WireGuardStop.
+func (mr *MockAPIMockRecorder) WireGuardStop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStop", reflect.TypeOf((*MockAPI)(nil).WireGuardStop))
+}
+
+// WireGuardStopCtx mocks base method.
+func (m *MockAPI) WireGuardStopCtx(ctx context.Context) (*opnsense.GenericResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WireGuardStopCtx", ctx)
+	ret0, _ := ret[0].(*opnsense.GenericResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WireGuardStopCtx indicates an expected call of WireGuardStopCtx.
+func (mr *MockAPIMockRecorder) WireGuardStopCtx(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WireGuardStopCtx", reflect.TypeOf((*MockAPI)(nil).WireGuardStopCtx), ctx)
+}
@@ -0,0 +1,61 @@
+package mocks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+	"github.com/kradalby/opnsense-go/opnsense/mocks"
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/mock/gomock"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+// ensureAlias is the kind of code the interfaces are meant for: it only needs
+// the alias part of the client.
+func ensureAlias(api opnsense.AliasAPI, alias opnsense.AliasFormat) (*uuid.UUID, error) {
+	id, err := api.AliasAdd(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = api.AliasReconfigure()
+	if err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+func TestMockAliasAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mocks.NewMockAliasAPI(ctrl)
+
+	id := uuid.NewV4()
+	alias := opnsense.AliasFormat{Name: "webservers", Type: "host"}
+
+	gomock.InOrder(
+		api.EXPECT().AliasAdd(alias).Return(&id, nil),
+		api.EXPECT().AliasReconfigure().Return(&opnsense.AliasReconfigureResponse{Status: "ok"}, nil),
+	)
+
+	got, err := ensureAlias(api, alias)
+	if err != nil || !uuid.Equal(*got, id) {
+		t.Errorf("Expected %s, got %v, %v", id, got, err)
+	}
+}
+
+func TestMockAPIFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// MockAPI covers every domain, like *opnsense.Client.
+	var api opnsense.API = mocks.NewMockAPI(ctrl)
+
+	api.(*mocks.MockAPI).EXPECT().AliasAdd(gomock.Any()).Return(nil, errUnavailable)
+
+	_, err := ensureAlias(api, opnsense.AliasFormat{})
+	if !errors.Is(err, errUnavailable) {
+		t.Errorf("Expected error to be passed on, got %v", err)
+	}
+}
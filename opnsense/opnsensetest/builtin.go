@@ -0,0 +1,652 @@
+package opnsensetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const defaultBackup = `<?xml version="1.0"?>
+<opnsense>
+  <version>24.7</version>
+  <system>
+    <hostname>opnsensetest</hostname>
+    <domain>localdomain</domain>
+  </system>
+</opnsense>
+`
+
+var aliasNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+
+func (s *Server) registerDefaults() {
+	s.Register(&Model{
+		Module: "firewall/alias",
+		Key:    "alias",
+		Suffix: "Item",
+		Options: map[string][]string{
+			"type": {
+				"host", "network", "port", "url", "urltable", "geoip",
+				"networkgroup", "mac", "asn", "dynipv6host", "authgroup", "internal", "external",
+			},
+			"proto":   {"IPv4", "IPv6"},
+			"content": {},
+		},
+		Separators: map[string]string{"content": "\n"},
+		Defaults: map[string]string{
+			"enabled":     "1",
+			"name":        "",
+			"type":        "host",
+			"proto":       "",
+			"content":     "",
+			"updatefreq":  "",
+			"counters":    "0",
+			"description": "",
+		},
+		Required: []string{"name", "type"},
+		Unique:   []string{"name"},
+		Validate: func(item map[string]string) map[string]string {
+			if item["name"] != "" && !aliasNameRegexp.MatchString(item["name"]) {
+				return map[string]string{
+					"name": "The name must be less than 32 characters long and may only consist of the following characters: a-z, A-Z, 0-9, _",
+				}
+			}
+
+			return nil
+		},
+	})
+
+	s.Register(&Model{
+		Module: "firewall/filter",
+		Key:    "rule",
+		Suffix: "Rule",
+		Options: map[string][]string{
+			"action":     {"pass", "block", "reject"},
+			"interface":  {},
+			"direction":  {"in", "out"},
+			"ipprotocol": {"inet", "inet6", "inet46"},
+			"protocol":   {},
+			"gateway":    {},
+		},
+		Labels: map[string]map[string]string{
+			"action":     {"pass": "Pass", "block": "Block", "reject": "Reject"},
+			"direction":  {"in": "In", "out": "Out"},
+			"ipprotocol": {"inet": "IPv4", "inet6": "IPv6", "inet46": "IPv4+IPv6"},
+		},
+		Relations: map[string]Relation{
+			"categories": {Module: "firewall/category", Field: "name"},
+		},
+		Filters: map[string]string{"category": "categories"},
+		Defaults: map[string]string{
+			"enabled":          "1",
+			"sequence":         "1",
+			"action":           "pass",
+			"quick":            "1",
+			"interface":        "",
+			"direction":        "in",
+			"ipprotocol":       "inet",
+			"protocol":         "any",
+			"source_net":       "any",
+			"source_not":       "0",
+			"source_port":      "",
+			"destination_net":  "any",
+			"destination_not":  "0",
+			"destination_port": "",
+			"gateway":          "",
+			"log":              "0",
+			"description":      "",
+		},
+	})
+
+	s.Register(&Model{
+		Module: "firewall/category",
+		Key:    "category",
+		Suffix: "Item",
+		Defaults: map[string]string{
+			"name":  "",
+			"auto":  "0",
+			"color": "",
+		},
+		Required: []string{"name"},
+		Unique:   []string{"name"},
+	})
+
+	s.Register(&Model{
+		Module: "firewall/group",
+		Key:    "group",
+		Suffix: "Item",
+		Options: map[string][]string{
+			"members": {},
+		},
+		Defaults: map[string]string{
+			"ifname":   "",
+			"members":  "",
+			"nogroup":  "0",
+			"sequence": "0",
+			"descr":    "",
+		},
+		Required: []string{"ifname"},
+		Unique:   []string{"ifname"},
+	})
+
+	s.Register(&Model{
+		Module: "firewall/source_nat",
+		Key:    "rule",
+		Suffix: "Rule",
+		Options: map[string][]string{
+			"interface":  {},
+			"ipprotocol": {"inet", "inet6"},
+			"protocol":   {},
+		},
+		Labels: map[string]map[string]string{
+			"ipprotocol": {"inet": "IPv4", "inet6": "IPv6"},
+		},
+		Filters: map[string]string{"category": "categories"},
+		Defaults: map[string]string{
+			"enabled":          "1",
+			"nonat":            "0",
+			"sequence":         "1",
+			"interface":        "wan",
+			"ipprotocol":       "inet",
+			"protocol":         "any",
+			"source_net":       "any",
+			"source_not":       "0",
+			"source_port":      "",
+			"destination_net":  "any",
+			"destination_not":  "0",
+			"destination_port": "",
+			"target":           "",
+			"target_port":      "",
+			"staticnatport":    "0",
+			"log":              "0",
+			"description":      "",
+		},
+	})
+
+	s.Register(&Model{
+		Module: "firewall/d_nat",
+		Key:    "rule",
+		Suffix: "Rule",
+		Options: map[string][]string{
+			"interface":               {},
+			"ipprotocol":              {"inet", "inet6"},
+			"protocol":                {},
+			"filter-rule-association": {"add-associated", "add-unassociated", "pass"},
+		},
+		Labels: map[string]map[string]string{
+			"ipprotocol": {"inet": "IPv4", "inet6": "IPv6"},
+			"filter-rule-association": {
+				"add-associated":   "Add associated filter rule",
+				"add-unassociated": "Add unassociated filter rule",
+				"pass":             "Pass",
+			},
+		},
+		Filters: map[string]string{"category": "category"},
+		Defaults: map[string]string{
+			"disabled":                "0",
+			"nordr":                   "0",
+			"sequence":                "1",
+			"interface":               "wan",
+			"ipprotocol":              "inet",
+			"protocol":                "any",
+			"source.network":          "any",
+			"source.not":              "0",
+			"source.port":             "",
+			"destination.network":     "any",
+			"destination.not":         "0",
+			"destination.port":        "",
+			"target":                  "",
+			"local-port":              "",
+			"filter-rule-association": "",
+			"log":                     "0",
+			"category":                "",
+			"descr":                   "",
+		},
+		Disabled: true,
+	})
+
+	s.Register(&Model{
+		Module: "firewall/one_to_one",
+		Key:    "rule",
+		Suffix: "Rule",
+		Options: map[string][]string{
+			"interface": {},
+			"type":      {"binat", "nat"},
+		},
+		Labels: map[string]map[string]string{
+			"type": {"binat": "BINAT", "nat": "NAT"},
+		},
+		Filters: map[string]string{"category": "categories"},
+		Defaults: map[string]string{
+			"enabled":         "1",
+			"log":             "0",
+			"sequence":        "1",
+			"interface":       "wan",
+			"type":            "binat",
+			"source_net":      "",
+			"source_not":      "0",
+			"destination_net": "any",
+			"destination_not": "0",
+			"external":        "",
+			"natreflection":   "",
+			"description":     "",
+		},
+		Required: []string{"source_net", "external"},
+	})
+
+	s.Register(&Model{
+		Module: "firewall/npt",
+		Key:    "rule",
+		Suffix: "Rule",
+		Options: map[string][]string{
+			"interface": {},
+			"trackif":   {},
+		},
+		Filters: map[string]string{"category": "categories"},
+		Defaults: map[string]string{
+			"enabled":         "1",
+			"log":             "0",
+			"sequence":        "1",
+			"interface":       "wan",
+			"source_net":      "",
+			"destination_net": "",
+			"trackif":         "",
+			"description":     "",
+		},
+		Required: []string{"source_net"},
+	})
+
+	s.Register(&Model{
+		Module: "quagga/bgp",
+		Key:    "neighbor",
+		Suffix: "Neighbor",
+		Options: map[string][]string{
+			"updatesource":        {},
+			"linkedPrefixlistIn":  {},
+			"linkedPrefixlistOut": {},
+			"linkedRoutemapIn":    {},
+			"linkedRoutemapOut":   {},
+		},
+		Defaults: map[string]string{
+			"enabled":          "1",
+			"address":          "",
+			"remoteas":         "",
+			"nexthopself":      "0",
+			"defaultoriginate": "0",
+		},
+		Required: []string{"address", "remoteas"},
+	})
+
+	s.Register(&Model{
+		Module: "wireguard/client",
+		Key:    "client",
+		Suffix: "client",
+		Options: map[string][]string{
+			"tunneladdress": {},
+		},
+		Defaults: map[string]string{
+			"enabled":       "1",
+			"name":          "",
+			"pubkey":        "",
+			"psk":           "",
+			"tunneladdress": "",
+			"serveraddress": "",
+			"serverport":    "",
+			"keepalive":     "",
+		},
+		Required: []string{"name", "pubkey", "tunneladdress"},
+		Unique:   []string{"name"},
+	})
+
+	s.Register(&Model{
+		Module: "wireguard/server",
+		Key:    "server",
+		Suffix: "server",
+		Options: map[string][]string{
+			"dns":           {},
+			"tunneladdress": {},
+			"peers":         {},
+		},
+		Defaults: map[string]string{
+			"enabled":       "1",
+			"name":          "",
+			"pubkey":        "",
+			"privkey":       "",
+			"port":          "",
+			"mtu":           "",
+			"disableroutes": "0",
+		},
+		Required: []string{"name"},
+		Unique:   []string{"name"},
+	})
+}
+
+// serveBuiltin answers the endpoints that are not part of a model: service
+// control, applying the firewall, the interface overview, firmware and
+// backup.
+func (s *Server) serveBuiltin(w http.ResponseWriter, r *http.Request, module, action string, args []string) bool {
+	ok := map[string]string{"status": "ok"}
+
+	switch module + "/" + strings.ToLower(action) {
+	case "firewall/alias/reconfigure", "firewall/group/reconfigure", "quagga/service/reconfigure", "wireguard/service/reconfigure",
+		"firewall/filter/cancelrollback", "firewall/filter/revert":
+		writeJSON(w, http.StatusOK, ok)
+	case "firewall/filter/apply":
+		writeJSON(w, http.StatusOK, map[string]string{"status": "OK\n\n"})
+	case "firewall/filter/savepoint":
+		writeJSON(w, http.StatusOK, map[string]string{
+			"status":    "ok",
+			"retention": "60",
+			"revision":  fmt.Sprintf("%.4f", float64(time.Now().UnixNano())/float64(time.Second)),
+		})
+	case "wireguard/service/restart", "wireguard/service/start", "wireguard/service/stop":
+		writeJSON(w, http.StatusOK, map[string]string{"response": "OK\n"})
+	case "wireguard/service/showconf", "wireguard/service/showhandshake":
+		writeJSON(w, http.StatusOK, map[string]string{"response": ""})
+	case "wireguard/general/get":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"general": map[string]string{"enabled": s.setting("wireguard.enabled", "0")},
+		})
+	case "wireguard/general/set":
+		var request struct {
+			General struct {
+				Enabled string `json:"enabled"`
+			} `json:"general"`
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &request)
+
+		s.set("wireguard.enabled", request.General.Enabled)
+		writeJSON(w, http.StatusOK, map[string]string{"result": "saved"})
+	case "interfaces/overview/interfacesinfo":
+		rows := s.interfaceRows()
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"rows":     rows,
+			"rowCount": len(rows),
+			"total":    len(rows),
+			"current":  1,
+		})
+	case "backup/backup/download":
+		s.mu.Lock()
+		backup := s.backup
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", "attachment; filename=config.xml")
+		_, _ = w.Write([]byte(backup))
+	default:
+		if module == "core/firmware" {
+			return s.firmware.serve(w, r, strings.ToLower(action), args)
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// Interface is an assigned interface listed by
+// interfaces/overview/interfacesInfo.
+type Interface struct {
+	Identifier  string
+	Device      string
+	Description string
+	// Down marks the interface as down, interfaces are up by default.
+	Down bool
+	IPv4 []string
+	IPv6 []string
+}
+
+// SetInterface adds an interface, replacing the one with the same
+// identifier. The server starts with wan and lan.
+func (s *Server) SetInterface(iface Interface) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.interfaces {
+		if existing.Identifier == iface.Identifier {
+			s.interfaces[i] = iface
+
+			return
+		}
+	}
+
+	s.interfaces = append(s.interfaces, iface)
+}
+
+func (s *Server) interfaceRows() []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	addresses := func(cidrs []string) []map[string]string {
+		list := []map[string]string{}
+		for _, cidr := range cidrs {
+			list = append(list, map[string]string{"ipaddr": cidr})
+		}
+
+		return list
+	}
+
+	rows := []map[string]interface{}{}
+
+	for _, iface := range s.interfaces {
+		status := "up"
+		if iface.Down {
+			status = "down"
+		}
+
+		rows = append(rows, map[string]interface{}{
+			"identifier":  iface.Identifier,
+			"device":      iface.Device,
+			"description": iface.Description,
+			"enabled":     true,
+			"status":      status,
+			"macaddr":     "00:00:5e:00:53:01",
+			"ipv4":        addresses(iface.IPv4),
+			"ipv6":        addresses(iface.IPv6),
+		})
+	}
+
+	return rows
+}
+
+// firmware keeps the packages and settings changed through core/firmware.
+type firmware struct {
+	mu       sync.Mutex
+	plugins  map[string]bool
+	locked   map[string]bool
+	settings map[string]string
+}
+
+func newFirmware() *firmware {
+	return &firmware{
+		plugins: map[string]bool{
+			"os-wireguard":   true,
+			"os-frr":         true,
+			"os-api-backup":  true,
+			"os-haproxy":     false,
+			"os-acme-client": false,
+		},
+		locked: map[string]bool{},
+		settings: map[string]string{
+			"mirror":  "https://pkg.opnsense.org",
+			"flavour": "OpenSSL",
+			"type":    "",
+		},
+	}
+}
+
+// SetPlugin adds or changes a plugin listed by core/firmware/info.
+func (s *Server) SetPlugin(name string, installed bool) {
+	s.firmware.mu.Lock()
+	defer s.firmware.mu.Unlock()
+
+	s.firmware.plugins[name] = installed
+}
+
+// Plugins returns the installed plugins.
+func (s *Server) Plugins() []string {
+	s.firmware.mu.Lock()
+	defer s.firmware.mu.Unlock()
+
+	installed := []string{}
+
+	for name, ok := range s.firmware.plugins {
+		if ok {
+			installed = append(installed, name)
+		}
+	}
+
+	sort.Strings(installed)
+
+	return installed
+}
+
+func (s *Server) setting(key, fallback string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.settings[key]; ok {
+		return v
+	}
+
+	return fallback
+}
+
+func (s *Server) set(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.settings[key] = value
+}
+
+func (f *firmware) serve(w http.ResponseWriter, r *http.Request, action string, args []string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	started := map[string]interface{}{
+		"status":   "ok",
+		"msg_uuid": uuid.NewV4().String(),
+	}
+
+	pkg := ""
+	if len(args) > 0 {
+		pkg = args[0]
+	}
+
+	switch action {
+	case "status":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"connection":      "ok",
+			"product_name":    "OPNsense",
+			"product_version": "24.7",
+			"repository":      "ok",
+			"updates":         "0",
+			"status":          "none",
+			"status_msg":      "There are no updates available on the selected mirror.",
+		})
+	case "info":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"product_name":    "OPNsense",
+			"product_version": "24.7",
+			"package":         []interface{}{},
+			"plugin":          f.pluginList(),
+			"changelog":       []interface{}{},
+		})
+	case "upgradestatus":
+		writeJSON(w, http.StatusOK, map[string]string{"status": "done", "log": ""})
+	case "getfirmwareconfig":
+		writeJSON(w, http.StatusOK, map[string]string{
+			"mirror":  f.settings["mirror"],
+			"flavour": f.settings["flavour"],
+			"type":    f.settings["type"],
+		})
+	case "getfirmwareoptions":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"has_subscription": []string{},
+			"flavours":         []map[string]string{{"OpenSSL": "OpenSSL"}},
+			"families":         []map[string]string{{"": "Production"}},
+			"mirrors":          []map[string]string{{"https://pkg.opnsense.org": "OPNsense"}},
+			"allow_custom":     true,
+		})
+	case "setfirmwareconfig":
+		var config map[string]string
+
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &config)
+
+		for _, key := range []string{"mirror", "flavour", "type"} {
+			f.settings[key] = config[key]
+		}
+
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	case "poweroff", "reboot", "upgrade", "audit", "details", "license":
+		writeJSON(w, http.StatusOK, started)
+	case "install", "reinstall":
+		f.plugins[pkg] = true
+		writeJSON(w, http.StatusOK, started)
+	case "remove":
+		if f.locked[pkg] {
+			writeJSON(w, http.StatusOK, map[string]string{"status": "failed"})
+
+			return true
+		}
+
+		f.plugins[pkg] = false
+		writeJSON(w, http.StatusOK, started)
+	case "lock":
+		f.locked[pkg] = true
+		writeJSON(w, http.StatusOK, started)
+	case "unlock":
+		delete(f.locked, pkg)
+		writeJSON(w, http.StatusOK, started)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (f *firmware) pluginList() []map[string]string {
+	names := make([]string, 0, len(f.plugins))
+	for name := range f.plugins {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	plugins := []map[string]string{}
+
+	for _, name := range names {
+		installed, locked := "0", "N/A"
+		if f.plugins[name] {
+			installed = "1"
+		}
+
+		if f.locked[name] {
+			locked = "1"
+		}
+
+		plugins = append(plugins, map[string]string{
+			"name":       name,
+			"version":    "1.0",
+			"comment":    name + " plugin",
+			"locked":     locked,
+			"repository": "OPNsense",
+			"origin":     "opnsense/" + name,
+			"provided":   "1",
+			"installed":  installed,
+			"configured": installed,
+		})
+	}
+
+	return plugins
+}
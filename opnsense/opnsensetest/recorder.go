@@ -0,0 +1,257 @@
+package opnsensetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+)
+
+// RecordEnv is the environment variable RecorderMode looks at to decide
+// whether fixtures are recorded or replayed.
+const RecordEnv = "OPNSENSE_RECORD"
+
+var (
+	ErrNoInteraction = errors.New("no recorded interaction matches the request")
+	ErrNotRecording  = errors.New("recorder is replaying, there is nothing to save")
+)
+
+type Mode int
+
+const (
+	// Replay answers requests from the fixture without touching the network.
+	Replay Mode = iota
+	// Record passes requests on to a real firewall and keeps the sanitised
+	// exchanges for Save.
+	Record
+)
+
+// RecorderMode returns Record if OPNSENSE_RECORD is set to a true value and
+// Replay otherwise, so fixtures can be refreshed from a lab firewall with
+// OPNSENSE_RECORD=1 go test ./....
+func RecorderMode() Mode {
+	switch strings.ToLower(os.Getenv(RecordEnv)) {
+	case "1", "true", "yes":
+		return Record
+	}
+
+	return Replay
+}
+
+// Interaction is a request and the response OPNsense gave to it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body"`
+}
+
+// Recorder is an http.RoundTripper recording exchanges with OPNsense into a
+// fixture file and replaying them.
+//
+// Only the method, path, query and body of requests are kept, so neither the
+// address of the firewall nor the Authorization header end up in the
+// fixture. Bodies are passed through Redact, which defaults to
+// opnsense.RedactBody and removes keys, secrets, passwords and backups.
+type Recorder struct {
+	// Redact sanitises request and response bodies before they are stored.
+	Redact func(body []byte) []byte
+
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder returns a recorder for the fixture at path. In Replay mode the
+// fixture is loaded immediately. In Record mode requests are sent with
+// transport, http.DefaultTransport if nil.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		Redact:    opnsense.RedactBody,
+		path:      path,
+		mode:      mode,
+		transport: transport,
+	}
+
+	if mode == Record {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, &r.interactions)
+	if err != nil {
+		return nil, fmt.Errorf("parsing fixture %s: %w", path, err)
+	}
+
+	r.used = make([]bool, len(r.interactions))
+
+	return r, nil
+}
+
+// Option configures a client to send its requests through the recorder.
+func (r *Recorder) Option() opnsense.Option {
+	return opnsense.WithHTTPClient(&http.Client{Transport: r})
+}
+
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if request.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		request.Body.Close()
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := RecordedRequest{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Query:  request.URL.RawQuery,
+		Body:   string(r.Redact(body)),
+	}
+
+	if r.mode == Replay {
+		return r.replay(request, recorded)
+	}
+
+	response, err := r.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode:  response.StatusCode,
+			ContentType: response.Header.Get("Content-Type"),
+			Body:        string(r.Redact(responseBody)),
+		},
+	})
+	r.mu.Unlock()
+
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	return response, nil
+}
+
+// replay answers with the first unused interaction for the same method and
+// path, preferring one with the same query and body.
+func (r *Recorder) replay(request *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request.Method != recorded.Method || interaction.Request.Path != recorded.Path {
+			continue
+		}
+
+		if interaction.Request == recorded {
+			match = i
+
+			break
+		}
+
+		if match < 0 {
+			match = i
+		}
+	}
+
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.Path)
+	}
+
+	r.used[match] = true
+	response := r.interactions[match].Response
+
+	header := http.Header{}
+	if response.ContentType != "" {
+		header.Set("Content-Type", response.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(response.Body)),
+		ContentLength: int64(len(response.Body)),
+		Request:       request,
+	}, nil
+}
+
+// Interactions returns the exchanges recorded or loaded so far.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Save writes the recorded exchanges to the fixture file.
+func (r *Recorder) Save() error {
+	if r.mode != Record {
+		return ErrNotRecording
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(r.path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0o600)
+}
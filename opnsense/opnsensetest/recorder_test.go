@@ -0,0 +1,105 @@
+package opnsensetest_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+	"github.com/kradalby/opnsense-go/opnsense/opnsensetest"
+)
+
+func TestRecorderRoundTrip(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "fixtures", "wireguard.json")
+
+	server := opnsensetest.NewServer()
+
+	recorder, err := opnsensetest.NewRecorder(fixture, opnsensetest.Record, nil)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %s", err)
+	}
+
+	client, err := server.Client(recorder.Option())
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	_, err = client.WireGuardServerAdd(opnsense.WireGuardServerSet{
+		WireGuardServerBase: opnsense.WireGuardServerBase{
+			Name:    "wg0",
+			PrivKey: "c2VjcmV0LXByaXZhdGUta2V5",
+		},
+		TunnelAddress: "10.10.0.1/24",
+	})
+	if err != nil {
+		t.Fatalf("Failed to add server: %s", err)
+	}
+
+	recorded, err := client.WireGuardServerList()
+	if err != nil || len(recorded) != 1 {
+		t.Fatalf("Expected one server, got %v, %v", recorded, err)
+	}
+
+	err = recorder.Save()
+	if err != nil {
+		t.Fatalf("Failed to save fixture: %s", err)
+	}
+
+	server.Close()
+
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %s", err)
+	}
+
+	for _, secret := range []string{"c2VjcmV0LXByaXZhdGUta2V5", opnsensetest.Secret, server.URL} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Fixture contains %q:\n%s", secret, data)
+		}
+	}
+
+	replayer, err := opnsensetest.NewRecorder(fixture, opnsensetest.Replay, nil)
+	if err != nil {
+		t.Fatalf("Failed to load fixture: %s", err)
+	}
+
+	client, err = opnsense.New(opnsense.WithBaseURL("https://firewall.invalid"), replayer.Option())
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	replayed, err := client.WireGuardServerList()
+	if err != nil || len(replayed) != 1 {
+		t.Fatalf("Expected one server, got %v, %v", replayed, err)
+	}
+
+	if replayed[0].Name != "wg0" || replayed[0].TunnelAddress["10.10.0.1/24"].Selected != 1 {
+		t.Errorf("Unexpected server: %#v", replayed[0])
+	}
+
+	// Every interaction is used once.
+	_, err = client.WireGuardServerList()
+	if !errors.Is(err, opnsensetest.ErrNoInteraction) {
+		t.Errorf("Expected fixture to be exhausted, got %v", err)
+	}
+
+	if !errors.Is(replayer.Save(), opnsensetest.ErrNotRecording) {
+		t.Errorf("Expected replaying recorder not to save")
+	}
+}
+
+func TestRecorderMode(t *testing.T) {
+	t.Setenv(opnsensetest.RecordEnv, "1")
+
+	if opnsensetest.RecorderMode() != opnsensetest.Record {
+		t.Errorf("Expected record mode")
+	}
+
+	t.Setenv(opnsensetest.RecordEnv, "")
+
+	if opnsensetest.RecorderMode() != opnsensetest.Replay {
+		t.Errorf("Expected replay mode")
+	}
+}
@@ -0,0 +1,946 @@
+// Package opnsensetest provides an in-memory imitation of the OPNsense API
+// for testing code that uses the opnsense package without a firewall.
+//
+// The server implements the MVC endpoints of the aliases, filter rules,
+// categories, interface groups, source and destination NAT, one-to-one NAT,
+// NPT, BGP neighbors and WireGuard clients and servers the opnsense package
+// supports, plus the interface overview, firmware, backup and the service
+// actions around them, including the savepoint, apply, cancelRollback and
+// revert steps of a filter transaction. Interfaces are set up with
+// SetInterface.
+//
+// It reproduces the parts of OPNsense clients tend to trip over: basic
+// authentication, validation errors in the result of add and set, and get
+// returning [] for items that do not exist.
+//
+//	server := opnsensetest.NewServer()
+//	defer server.Close()
+//
+//	client, err := server.Client()
+package opnsensetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// Key and Secret are the credentials the server accepts by default.
+	Key    = "opnsensetest-key"
+	Secret = "opnsensetest-secret"
+)
+
+// Server is a running fake OPNsense API.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	key      string
+	secret   string
+	models   map[string]*Model
+	handlers map[string]http.HandlerFunc
+	requests []string
+	settings map[string]string
+
+	firmware   *firmware
+	backup     string
+	interfaces []Interface
+}
+
+// NewServer starts a server with the default models registered.
+func NewServer() *Server {
+	s := &Server{
+		key:      Key,
+		secret:   Secret,
+		models:   map[string]*Model{},
+		handlers: map[string]http.HandlerFunc{},
+		settings: map[string]string{},
+		firmware: newFirmware(),
+		backup:   defaultBackup,
+		interfaces: []Interface{
+			{Identifier: "wan", Device: "vtnet0", Description: "WAN", IPv4: []string{"192.0.2.1/24"}},
+			{Identifier: "lan", Device: "vtnet1", Description: "LAN", IPv4: []string{"192.168.1.1/24"}},
+		},
+	}
+
+	s.registerDefaults()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a client for the server using its credentials. opts are
+// applied after the defaults and may override them.
+func (s *Server) Client(opts ...opnsense.Option) (*opnsense.Client, error) {
+	s.mu.Lock()
+	key, secret := s.key, s.secret
+	s.mu.Unlock()
+
+	return opnsense.New(append([]opnsense.Option{
+		opnsense.WithBaseURL(s.URL),
+		opnsense.WithCredentials(key, secret),
+	}, opts...)...)
+}
+
+// SetCredentials changes the credentials the server checks the basic
+// authentication of every request against.
+func (s *Server) SetCredentials(key, secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.key, s.secret = key, secret
+}
+
+// Register adds a model, replacing any registered for the same module.
+func (s *Server) Register(m *Model) *Model {
+	if m.items == nil {
+		m.items = map[string]map[string]string{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m.server = s
+	s.models[m.Module] = m
+
+	return m
+}
+
+// Model returns the model registered for module, e.g. firewall/alias.
+func (s *Server) Model(module string) *Model {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.models[module]
+}
+
+// Handle serves route, e.g. firewall/filter/apply, with handler instead of
+// the behaviour of the server. Routes are matched on their first three path
+// segments, so arguments such as revisions are passed on to handler.
+func (s *Server) Handle(route string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[strings.ToLower(route)] = handler
+}
+
+// Requests returns every request received so far as "METHOD /api/path".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+// Count returns the number of requests received for route, e.g.
+// firewall/alias/reconfigure, ignoring the arguments following it.
+func (s *Server) Count(route string) int {
+	count := 0
+	prefix := "/api/" + strings.ToLower(strings.Trim(route, "/"))
+
+	for _, request := range s.Requests() {
+		_, p, _ := strings.Cut(request, " ")
+		p = strings.ToLower(p)
+
+		if p == prefix || strings.HasPrefix(p, prefix+"/") {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SetBackup sets the configuration returned by backup/backup/download.
+func (s *Server) SetBackup(config string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.backup = config
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	key, secret := s.key, s.secret
+	s.mu.Unlock()
+
+	user, password, ok := r.BasicAuth()
+	if !ok || user != key || password != secret {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"status":  http.StatusUnauthorized,
+			"message": "Authentication Failed",
+		})
+
+		return
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"), "/"), "/")
+	if len(segments) < 3 {
+		notFound(w)
+
+		return
+	}
+
+	module := segments[0] + "/" + segments[1]
+	action := segments[2]
+	args := segments[3:]
+
+	s.mu.Lock()
+	handler := s.handlers[strings.ToLower(module+"/"+action)]
+	model := s.models[module]
+	s.mu.Unlock()
+
+	switch {
+	case handler != nil:
+		handler(w, r)
+	case model != nil && model.serve(w, r, action, args):
+	case s.serveBuiltin(w, r, module, action, args):
+	default:
+		notFound(w)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func notFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, map[string]string{
+		"errorMessage": "Endpoint not found",
+		"errorTitle":   "An error occurred",
+	})
+}
+
+// Model is an ArrayField exposed by an ApiMutableModelControllerBase, e.g.
+// the aliases served by firewall/alias/{search,get,add,set,del,toggle}Item.
+// Items are stored the way OPNsense stores them, as flat maps of strings.
+// Fields in containers, like the source of a port forward, are stored under
+// their path, e.g. source.network, which search shows as is and get nests.
+type Model struct {
+	// Module is the path of the controller, e.g. firewall/alias.
+	Module string
+	// Key wraps the item in requests and responses, e.g. alias.
+	Key string
+	// Suffix is appended to the actions, e.g. Item for getItem.
+	Suffix string
+	// Options lists the choices of option fields, which get presents as a
+	// map of choices with the selected ones marked. Values stored in these
+	// fields are comma separated and always selectable, so the choices can
+	// be left empty.
+	Options map[string][]string
+	// Labels are the display values of the choices of option fields, e.g.
+	// Pass for the pass action. get presents them as the value of each
+	// choice and search shows them instead of the stored keys.
+	Labels map[string]map[string]string
+	// Relations maps fields holding the UUIDs of items of another model to
+	// that model, like a ModelRelationField. Every item of the model is a
+	// choice, labelled with the field named by the relation.
+	Relations map[string]Relation
+	// Separators overrides the comma separating the values of option
+	// fields, e.g. the newline between the entries of alias content.
+	Separators map[string]string
+	// Filters maps the additional search parameters the controller accepts
+	// to the field they filter on, e.g. category to categories.
+	Filters map[string]string
+	// Defaults are applied to new items.
+	Defaults map[string]string
+	// Required fields fail validation when empty.
+	Required []string
+	// Unique fields fail validation when another item has the same value.
+	Unique []string
+	// Validate adds further validation messages keyed by field name.
+	Validate func(item map[string]string) map[string]string
+	// Disabled makes toggle flip a disabled field instead of enabled, like
+	// firewall/d_nat does.
+	Disabled bool
+
+	mu     sync.Mutex
+	items  map[string]map[string]string
+	order  []string
+	server *Server
+}
+
+// Relation points a field at the items of another model, e.g. the
+// categories of a filter rule at firewall/category.
+type Relation struct {
+	// Module of the model the field refers to.
+	Module string
+	// Field of the items shown as their display value, e.g. name.
+	Field string
+}
+
+// Insert stores item without validation and returns its UUID.
+func (m *Model) Insert(item map[string]string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.insert(m.withDefaults(item))
+}
+
+// Item returns a copy of the stored item.
+func (m *Model) Item(id string) (map[string]string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyItem(item), true
+}
+
+// UUIDs returns the UUIDs of the stored items in the order they were added.
+func (m *Model) UUIDs() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string(nil), m.order...)
+}
+
+// Len returns the number of stored items.
+func (m *Model) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.order)
+}
+
+func (m *Model) values(field, value string) []string {
+	separator, ok := m.Separators[field]
+	if !ok {
+		separator = ","
+	}
+
+	return strings.Split(value, separator)
+}
+
+func (m *Model) insert(item map[string]string) string {
+	id := uuid.NewV4().String()
+
+	m.items[id] = item
+	m.order = append(m.order, id)
+
+	return id
+}
+
+func (m *Model) withDefaults(item map[string]string) map[string]string {
+	stored := map[string]string{}
+
+	for k, v := range m.Defaults {
+		stored[k] = v
+	}
+
+	for k, v := range item {
+		stored[k] = v
+	}
+
+	return stored
+}
+
+func (m *Model) serve(w http.ResponseWriter, r *http.Request, action string, args []string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lower := strings.ToLower(action)
+	suffix := strings.ToLower(m.Suffix)
+
+	if !strings.HasSuffix(lower, suffix) {
+		return false
+	}
+
+	id := ""
+	if len(args) > 0 {
+		id = args[0]
+	}
+
+	switch strings.TrimSuffix(lower, suffix) {
+	case "search":
+		m.search(w, r)
+	case "get":
+		m.get(w, id)
+	case "add":
+		if !requirePost(w, r) {
+			return true
+		}
+
+		m.save(w, r, "")
+	case "set":
+		if !requirePost(w, r) {
+			return true
+		}
+
+		m.save(w, r, id)
+	case "del":
+		if !requirePost(w, r) {
+			return true
+		}
+
+		m.del(w, id)
+	case "toggle":
+		if !requirePost(w, r) {
+			return true
+		}
+
+		m.toggle(w, id, args[min(1, len(args)):])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func requirePost(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodPost {
+		return true
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"result": "failed"})
+
+	return false
+}
+
+func (m *Model) search(w http.ResponseWriter, r *http.Request) {
+	params := searchParams(r)
+
+	rows := []map[string]string{}
+
+	for _, id := range m.order {
+		// Filters match the stored keys, the search phrase what is shown.
+		if !m.filtered(m.items[id], params.filters) {
+			continue
+		}
+
+		row := m.row(id)
+
+		if params.phrase != "" && !matches(row, params.phrase) {
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	for field, direction := range params.sort {
+		sort.SliceStable(rows, func(i, j int) bool {
+			if direction == "desc" {
+				return less(rows[j][field], rows[i][field])
+			}
+
+			return less(rows[i][field], rows[j][field])
+		})
+	}
+
+	total := len(rows)
+
+	if params.rowCount > 0 {
+		from := min((params.current-1)*params.rowCount, total)
+		to := min(from+params.rowCount, total)
+		rows = rows[from:to]
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"rows":     rows,
+		"rowCount": len(rows),
+		"total":    total,
+		"current":  params.current,
+	})
+}
+
+// row presents an item the way search does, with option fields showing the
+// display values of the selected choices rather than every choice.
+func (m *Model) row(id string) map[string]string {
+	row := copyItem(m.items[id])
+	row["uuid"] = id
+
+	for _, field := range m.optionFields() {
+		_, labels := m.choices(field)
+		if len(labels) == 0 || row[field] == "" {
+			continue
+		}
+
+		values := m.values(field, row[field])
+		for i, value := range values {
+			if label, ok := labels[value]; ok {
+				values[i] = label
+			}
+		}
+
+		row[field] = strings.Join(values, ",")
+	}
+
+	return row
+}
+
+// optionFields returns the fields with choices, sorted.
+func (m *Model) optionFields() []string {
+	fields := slices.Collect(maps.Keys(m.Options))
+
+	for field := range m.Relations {
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	slices.Sort(fields)
+
+	return fields
+}
+
+// choices returns the choices of an option field and their display values.
+// The choices of a relation are the items of the related model.
+func (m *Model) choices(field string) ([]string, map[string]string) {
+	relation, ok := m.Relations[field]
+	if !ok {
+		return m.Options[field], m.Labels[field]
+	}
+
+	if m.server == nil {
+		return nil, nil
+	}
+
+	related := m.server.Model(relation.Module)
+	if related == nil {
+		return nil, nil
+	}
+
+	related.mu.Lock()
+	defer related.mu.Unlock()
+
+	labels := map[string]string{}
+	for _, id := range related.order {
+		labels[id] = related.items[id][relation.Field]
+	}
+
+	return slices.Clone(related.order), labels
+}
+
+func matches(row map[string]string, phrase string) bool {
+	phrase = strings.ToLower(phrase)
+
+	for k, v := range row {
+		if k != "uuid" && strings.Contains(strings.ToLower(v), phrase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filtered reports whether item has one of the values of every filter the
+// model accepts. Like the category filter of the firewall rules, a filter
+// matches any of the comma separated values of its field.
+func (m *Model) filtered(item map[string]string, filters map[string][]string) bool {
+	for param, values := range filters {
+		field, ok := m.Filters[param]
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		found := false
+
+		for _, have := range strings.Split(item[field], ",") {
+			found = found || slices.Contains(values, have)
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+type search struct {
+	current  int
+	rowCount int
+	phrase   string
+	sort     map[string]string
+	filters  map[string][]string
+}
+
+// searchParams reads the bootgrid parameters from the JSON body of a POST,
+// falling back to the defaults OPNsense uses for a plain GET.
+func searchParams(r *http.Request) search {
+	params := search{current: 1, rowCount: -1, sort: map[string]string{}, filters: map[string][]string{}}
+
+	if r.Method != http.MethodPost {
+		return params
+	}
+
+	var body map[string]interface{}
+
+	data, _ := io.ReadAll(r.Body)
+	if json.Unmarshal(data, &body) != nil {
+		return params
+	}
+
+	for k, v := range body {
+		switch k {
+		case "current":
+			params.current = max(toInt(v), 1)
+		case "rowCount":
+			params.rowCount = toInt(v)
+		case "searchPhrase":
+			params.phrase = fmt.Sprint(v)
+		case "sort":
+			if sorts, ok := v.(map[string]interface{}); ok {
+				for field, direction := range sorts {
+					params.sort[field] = fmt.Sprint(direction)
+				}
+			}
+		default:
+			switch value := v.(type) {
+			case []interface{}:
+				for _, item := range value {
+					params.filters[k] = append(params.filters[k], fmt.Sprint(item))
+				}
+			case string:
+				if value != "" {
+					params.filters[k] = strings.Split(value, ",")
+				}
+			}
+		}
+	}
+
+	return params
+}
+
+func toInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+
+		return i
+	}
+
+	return 0
+}
+
+func (m *Model) get(w http.ResponseWriter, id string) {
+	item := m.Defaults
+
+	if id != "" {
+		stored, ok := m.items[id]
+		if !ok {
+			// OPNsense answers with an empty list instead of a 404.
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			_, _ = w.Write([]byte("[]"))
+
+			return
+		}
+
+		item = stored
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		m.Key: m.render(item),
+	})
+}
+
+// render presents an item the way get does, with option fields as a map of
+// every choice and whether it is selected.
+func (m *Model) render(item map[string]string) map[string]interface{} {
+	rendered := map[string]interface{}{}
+
+	for k, v := range item {
+		rendered[k] = v
+	}
+
+	for _, field := range m.optionFields() {
+		choices, labels := m.choices(field)
+		label := func(choice string) string {
+			if l, ok := labels[choice]; ok {
+				return l
+			}
+
+			return choice
+		}
+
+		selected := map[string]bool{}
+
+		for _, value := range m.values(field, item[field]) {
+			if value != "" {
+				selected[value] = true
+			}
+		}
+
+		options := map[string]interface{}{}
+
+		for _, choice := range choices {
+			options[choice] = map[string]interface{}{"value": label(choice), "selected": 0}
+		}
+
+		for value := range selected {
+			options[value] = map[string]interface{}{"value": label(value), "selected": 1}
+		}
+
+		if len(options) == 0 {
+			// Empty option lists are encoded as [] by PHP.
+			rendered[field] = []interface{}{}
+
+			continue
+		}
+
+		rendered[field] = options
+	}
+
+	return nest(rendered)
+}
+
+// nest turns the fields stored under a path back into containers, the way
+// get presents them.
+func nest(fields map[string]interface{}) map[string]interface{} {
+	nested := map[string]interface{}{}
+	containers := map[string]map[string]interface{}{}
+
+	for k, v := range fields {
+		container, field, ok := strings.Cut(k, ".")
+		if !ok {
+			nested[k] = v
+
+			continue
+		}
+
+		if containers[container] == nil {
+			containers[container] = map[string]interface{}{}
+		}
+
+		containers[container][field] = v
+	}
+
+	for container, values := range containers {
+		nested[container] = nest(values)
+	}
+
+	return nested
+}
+
+func (m *Model) save(w http.ResponseWriter, r *http.Request, id string) {
+	var request map[string]map[string]interface{}
+
+	data, _ := io.ReadAll(r.Body)
+
+	err := json.Unmarshal(data, &request)
+	if err != nil {
+		writeJSON(w, http.StatusOK, map[string]string{"result": "failed"})
+
+		return
+	}
+
+	var item map[string]string
+
+	if id == "" {
+		item = m.withDefaults(nil)
+	} else {
+		stored, ok := m.items[id]
+		if !ok {
+			writeJSON(w, http.StatusOK, map[string]string{"result": "failed"})
+
+			return
+		}
+
+		item = copyItem(stored)
+	}
+
+	store(item, "", request[m.Key])
+
+	validations := m.validate(id, item)
+	if len(validations) > 0 {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"result":      "failed",
+			"validations": validations,
+		})
+
+		return
+	}
+
+	if id == "" {
+		id = m.insert(item)
+	} else {
+		m.items[id] = item
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"result": opnsense.StatusSaved,
+		"uuid":   id,
+	})
+}
+
+// store copies the fields sent by a client into item, with the fields of
+// containers stored under their path, e.g. source.network.
+func store(item map[string]string, prefix string, fields map[string]interface{}) {
+	for k, v := range fields {
+		if container, ok := v.(map[string]interface{}); ok {
+			store(item, prefix+k+".", container)
+
+			continue
+		}
+
+		item[prefix+k] = flatten(v)
+	}
+}
+
+// flatten converts a value sent by a client to the string OPNsense stores.
+func flatten(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case bool:
+		if value {
+			return "1"
+		}
+
+		return "0"
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case []interface{}:
+		values := []string{}
+		for _, item := range value {
+			values = append(values, flatten(item))
+		}
+
+		return strings.Join(values, ",")
+	}
+
+	data, _ := json.Marshal(v)
+
+	return string(data)
+}
+
+func (m *Model) validate(id string, item map[string]string) map[string]string {
+	validations := map[string]string{}
+
+	for _, field := range m.Required {
+		if item[field] == "" {
+			validations[m.Key+"."+field] = "A value is required."
+		}
+	}
+
+	for _, field := range m.optionFields() {
+		choices, _ := m.choices(field)
+		_, relation := m.Relations[field]
+
+		// Option fields without choices accept anything, relations only
+		// the items of the related model.
+		if (len(choices) == 0 && !relation) || item[field] == "" {
+			continue
+		}
+
+		for _, value := range m.values(field, item[field]) {
+			if !slices.Contains(choices, value) {
+				validations[m.Key+"."+field] = "Option not in list."
+			}
+		}
+	}
+
+	for _, field := range m.Unique {
+		for other, stored := range m.items {
+			if other != id && item[field] != "" && stored[field] == item[field] {
+				validations[m.Key+"."+field] = "This value should be unique."
+			}
+		}
+	}
+
+	if m.Validate != nil {
+		for field, message := range m.Validate(item) {
+			validations[m.Key+"."+field] = message
+		}
+	}
+
+	return validations
+}
+
+func (m *Model) del(w http.ResponseWriter, id string) {
+	if _, ok := m.items[id]; !ok {
+		writeJSON(w, http.StatusOK, map[string]string{"result": "not found"})
+
+		return
+	}
+
+	delete(m.items, id)
+
+	for i, other := range m.order {
+		if other == id {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+
+			break
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"result": opnsense.StatusDeleted})
+}
+
+func (m *Model) toggle(w http.ResponseWriter, id string, state []string) {
+	item, ok := m.items[id]
+	if !ok {
+		writeJSON(w, http.StatusOK, map[string]string{"result": opnsense.StatusFailed})
+
+		return
+	}
+
+	field, on, off := "enabled", "1", "0"
+	if m.Disabled {
+		field, on, off = "disabled", "0", "1"
+	}
+
+	enabled := item[field] != on
+	if len(state) > 0 {
+		enabled = state[0] == "1"
+	}
+
+	item[field] = off
+	result := "Disabled"
+
+	if enabled {
+		item[field] = on
+		result = "Enabled"
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"result":  result,
+		"changed": true,
+	})
+}
+
+// less compares numbers numerically, like the integer fields of OPNsense are
+// sorted, and everything else as strings.
+func less(a, b string) bool {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+
+	if errA == nil && errB == nil {
+		return x < y
+	}
+
+	return a < b
+}
+
+func copyItem(item map[string]string) map[string]string {
+	copied := make(map[string]string, len(item))
+
+	for k, v := range item {
+		copied[k] = v
+	}
+
+	return copied
+}
@@ -0,0 +1,1108 @@
+package opnsensetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kradalby/opnsense-go/opnsense"
+	"github.com/kradalby/opnsense-go/opnsense/opnsensetest"
+	uuid "github.com/satori/go.uuid"
+)
+
+func newClient(t *testing.T) (*opnsensetest.Server, *opnsense.Client) {
+	t.Helper()
+
+	server := opnsensetest.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	return server, client
+}
+
+func TestAlias(t *testing.T) {
+	server, client := newClient(t)
+
+	id, err := client.AliasAdd(opnsense.AliasFormat{
+		Enabled: true,
+		Name:    "webservers",
+		Type:    "host",
+		Content: []string{"10.0.0.1", "10.0.0.2"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add alias: %s", err)
+	}
+
+	alias, err := client.AliasGet(*id)
+	if err != nil {
+		t.Fatalf("Failed to get alias: %s", err)
+	}
+
+	if alias.Name != "webservers" || alias.Type != "host" || len(alias.Content) != 2 || !alias.Enabled {
+		t.Errorf("Unexpected alias: %#v", alias)
+	}
+
+	_, err = client.AliasAdd(opnsense.AliasFormat{Name: "webservers", Type: "host"})
+
+	var apiErr *opnsense.APIError
+	if !errors.As(err, &apiErr) || apiErr.Validations["alias.name"] == "" {
+		t.Errorf("Expected validation error for duplicate name, got %v", err)
+	}
+
+	alias.Description = "web"
+
+	_, err = client.AliasUpdate(*id, *alias)
+	if err != nil {
+		t.Fatalf("Failed to update alias: %s", err)
+	}
+
+	list, err := client.AliasGetList()
+	if err != nil || len(list.Rows) != 1 || list.Rows[0].Description != "web" {
+		t.Errorf("Unexpected alias list: %v, %v", list, err)
+	}
+
+	_, err = client.AliasReconfigure()
+	if err != nil {
+		t.Errorf("Failed to reconfigure: %s", err)
+	}
+
+	if server.Count("firewall/alias/reconfigure") != 1 {
+		t.Errorf("Expected one reconfigure, got %v", server.Requests())
+	}
+
+	_, err = client.AliasDelete(*id)
+	if err != nil {
+		t.Fatalf("Failed to delete alias: %s", err)
+	}
+
+	_, err = client.AliasGet(*id)
+	if !errors.Is(err, opnsense.ErrOpnsenseEmptyListNotFound) {
+		t.Errorf("Expected deleted alias to be missing, got %v", err)
+	}
+}
+
+func TestFilterRule(t *testing.T) {
+	server, client := newClient(t)
+
+	rule := opnsense.FilterRule{
+		Enabled:     true,
+		Interface:   "lan",
+		Gateway:     "WAN_GW",
+		Description: "allow lan",
+	}
+
+	id, err := client.FirewallFilterRuleAdd(&rule)
+	if err != nil {
+		t.Fatalf("Failed to add rule: %s", err)
+	}
+
+	if rule.UUID == nil || !uuid.Equal(*rule.UUID, *id) {
+		t.Errorf("Expected rule UUID to be set to %s, got %v", id, rule.UUID)
+	}
+
+	category := uuid.NewV4()
+
+	server.Model("firewall/filter").Insert(map[string]string{
+		"description": "allow lan",
+		"categories":  category.String(),
+	})
+
+	found, err := client.FirewallFilterRuleFindByDescription("allow lan")
+	if err != nil || len(found) != 2 {
+		t.Errorf("Expected to find both rules by description, got %v, %v", found, err)
+	}
+
+	found, err = client.FirewallFilterRuleFindByCategory(category)
+	if err != nil || len(found) != 1 || uuid.Equal(*found[0].UUID, *id) {
+		t.Errorf("Expected to find the inserted rule by category, got %v, %v", found, err)
+	}
+
+	server.Model("firewall/filter").Insert(map[string]string{"description": "allow lan and more"})
+
+	found, err = client.FirewallFilterRuleFindByDescription("allow lan")
+	if err != nil || len(found) != 2 {
+		t.Errorf("Expected only exact descriptions, got %v, %v", found, err)
+	}
+
+	got, err := client.FirewallFilterRuleGet(*id)
+	if err != nil {
+		t.Fatalf("Failed to get rule: %s", err)
+	}
+
+	if got.Description != "allow lan" || got.Action != opnsense.ActionPass || got.Gateway != "WAN_GW" || !uuid.Equal(*got.UUID, *id) {
+		t.Errorf("Unexpected rule: %#v", got)
+	}
+
+	rules, err := client.FirewallFilterRuleSearch()
+	if err != nil || len(rules) != 3 || rules[0].Direction != opnsense.DirectionIn {
+		t.Errorf("Unexpected rules: %v, %v", rules, err)
+	}
+
+	_, err = client.FirewallFilterRuleToggle(*id, false)
+	if err != nil {
+		t.Fatalf("Failed to toggle rule: %s", err)
+	}
+
+	stored, _ := server.Model("firewall/filter").Item(id.String())
+	if stored["enabled"] != "0" {
+		t.Errorf("Expected rule to be disabled, got %v", stored)
+	}
+
+	savepoint, err := client.FirewallFilterSavepoint()
+	if err != nil || savepoint.Revision == "" {
+		t.Fatalf("Failed to create savepoint: %v, %v", savepoint, err)
+	}
+
+	err = client.FirewallFilterApply(&savepoint.Revision)
+	if err != nil {
+		t.Errorf("Failed to apply: %s", err)
+	}
+
+	_, err = client.FirewallFilterCancelRollback(savepoint.Revision)
+	if err != nil {
+		t.Errorf("Failed to cancel rollback: %s", err)
+	}
+}
+
+func TestFilterRuleSetFalseBools(t *testing.T) {
+	server, client := newClient(t)
+
+	id := server.Model("firewall/filter").Insert(map[string]string{
+		"enabled":     "1",
+		"quick":       "1",
+		"log":         "1",
+		"description": "allow lan",
+	})
+
+	rule, err := client.FirewallFilterRuleGet(uuid.FromStringOrNil(id))
+	if err != nil {
+		t.Fatalf("Failed to get rule: %s", err)
+	}
+
+	rule.Enabled = false
+	rule.Log = false
+
+	err = client.FirewallFilterRuleSet(rule)
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	stored, _ := server.Model("firewall/filter").Item(id)
+	if stored["enabled"] != "0" || stored["log"] != "0" || stored["quick"] != "1" {
+		t.Errorf("Expected rule to be disabled without logging, got %v", stored)
+	}
+}
+
+func TestCategories(t *testing.T) {
+	server, client := newClient(t)
+
+	managed := opnsense.Category{Name: "terraform", Color: "00ff00"}
+
+	id, err := client.FirewallCategoryAdd(&managed)
+	if err != nil {
+		t.Fatalf("Failed to add category: %s", err)
+	}
+
+	_, err = client.FirewallCategoryAdd(&opnsense.Category{Name: "terraform"})
+	if err == nil {
+		t.Errorf("Expected duplicate category name to fail")
+	}
+
+	found, err := client.FirewallCategoryFindByName("terraform")
+	if err != nil || !uuid.Equal(*found.UUID, *id) || found.Color != "00ff00" {
+		t.Errorf("Unexpected category: %#v, %v", found, err)
+	}
+
+	_, err = client.FirewallCategoryFindByName("terra")
+	if !errors.Is(err, opnsense.ErrOpnsenseNotFound) {
+		t.Errorf("Expected partial name not to match, got %v", err)
+	}
+
+	for _, description := range []string{"managed 1", "managed 2"} {
+		_, err = client.FirewallFilterRuleAdd(&opnsense.FilterRule{
+			Description: description,
+			Categories:  &opnsense.Categories{*id},
+		})
+		if err != nil {
+			t.Fatalf("Failed to add rule: %s", err)
+		}
+	}
+
+	manual, err := client.FirewallFilterRuleAdd(&opnsense.FilterRule{Description: "manual"})
+	if err != nil {
+		t.Fatalf("Failed to add rule: %s", err)
+	}
+
+	rules, err := client.FirewallFilterRuleFindByCategory(*id)
+	if err != nil || len(rules) != 2 || rules[0].Categories == nil || !rules[0].Categories.Contains(*id) {
+		t.Fatalf("Unexpected rules in category: %v, %v", rules, err)
+	}
+
+	page, err := opnsense.Search[map[string]string](context.Background(), client, "firewall/filter/searchRule", opnsense.SearchOptions{})
+	if err != nil || len(page.Rows) != 3 || page.Rows[0]["categories"] != "terraform" || page.Rows[0]["action"] != "Pass" {
+		t.Errorf("Expected search to show display values, got %v, %v", page, err)
+	}
+
+	// Setting a rule found by search must not lose its categories.
+	rules[0].Description = "managed 1 renamed"
+
+	err = client.FirewallFilterRuleSet(rules[0])
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	stored, _ := server.Model("firewall/filter").Item(rules[0].UUID.String())
+	if stored["categories"] != id.String() {
+		t.Errorf("Expected set to keep the category, got %q", stored["categories"])
+	}
+
+	rule, err := client.FirewallFilterRuleGet(*rules[0].UUID)
+	if err != nil || rule.Categories == nil || len(*rule.Categories) != 1 || !rule.Categories.Contains(*id) {
+		t.Errorf("Expected get to return the category, got %v, %v", rule, err)
+	}
+
+	rule.Categories = &opnsense.Categories{}
+
+	err = client.FirewallFilterRuleSet(rule)
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	stored, _ = server.Model("firewall/filter").Item(rules[0].UUID.String())
+	if stored["categories"] != "" {
+		t.Errorf("Expected empty categories to clear them, got %q", stored["categories"])
+	}
+
+	rule.Categories = &opnsense.Categories{*id}
+
+	err = client.FirewallFilterRuleSet(rule)
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	deleted, err := client.FirewallFilterRuleDeleteByCategory(*id)
+	if err != nil || len(deleted) != 2 {
+		t.Errorf("Expected both rules to be deleted, got %v, %v", deleted, err)
+	}
+
+	if _, ok := server.Model("firewall/filter").Item(manual.String()); !ok || server.Model("firewall/filter").Len() != 1 {
+		t.Errorf("Expected only the manual rule to be left")
+	}
+
+	managed.Color = "0000ff"
+
+	err = client.FirewallCategorySet(&managed)
+	if err != nil {
+		t.Fatalf("Failed to set category: %s", err)
+	}
+
+	categories, err := client.FirewallCategorySearch()
+	if err != nil || len(categories) != 1 || categories[0].Color != "0000ff" {
+		t.Errorf("Unexpected categories: %v, %v", categories, err)
+	}
+
+	err = client.FirewallCategoryDelete(*id)
+	if err != nil {
+		t.Fatalf("Failed to delete category: %s", err)
+	}
+}
+
+func TestFilterRuleReconcile(t *testing.T) {
+	server, client := newClient(t)
+	model := server.Model("firewall/filter")
+
+	category, err := client.FirewallCategoryAdd(&opnsense.Category{Name: "gitops"})
+	if err != nil {
+		t.Fatalf("Failed to add category: %s", err)
+	}
+
+	manual := model.Insert(map[string]string{"sequence": "50", "description": "manual"})
+	model.Insert(map[string]string{"sequence": "100", "description": "ssh", "categories": category.String()})
+	stale := model.Insert(map[string]string{"sequence": "200", "description": "stale", "categories": category.String()})
+	web := model.Insert(map[string]string{
+		"sequence": "300", "description": "web", "action": "block", "categories": category.String(),
+	})
+
+	scope := opnsense.FilterRuleScope{Category: category}
+	desired := []opnsense.FilterRule{
+		{Description: "web", Enabled: true, Quick: true, Action: opnsense.ActionPass},
+		{Description: "ssh", Enabled: true, Quick: true},
+		{Description: "dns", Enabled: true, Quick: true, Action: opnsense.ActionPass, Interface: "lan"},
+	}
+
+	plan, err := client.FirewallFilterRulePlan(scope, desired)
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	if len(plan.Create) != 1 || len(plan.Update) != 1 || len(plan.Delete) != 1 || !plan.Reorder {
+		t.Fatalf("Unexpected plan: %+v", plan)
+	}
+
+	if plan.Update[0].Description != "web" || plan.Delete[0].UUID.String() != stale {
+		t.Errorf("Expected web to be updated and stale to be deleted, got %+v", plan)
+	}
+
+	changes := plan.Changes[*plan.Update[0].UUID]
+	if len(changes) != 1 || changes[0].String() != `action: "block" => "pass"` {
+		t.Errorf("Expected only the action of web to change, got %v", changes)
+	}
+
+	if model.Len() != 4 {
+		t.Errorf("Expected planning not to change anything")
+	}
+
+	applies := server.Count("firewall/filter/apply")
+
+	_, err = client.FirewallFilterRuleReconcile(scope, desired, nil)
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %s", err)
+	}
+
+	if server.Count("firewall/filter/apply")-applies != 1 {
+		t.Errorf("Expected the changes to be applied once")
+	}
+
+	rules, err := client.FirewallFilterRuleFindByCategory(*category)
+	if err != nil {
+		t.Fatalf("Failed to find rules: %s", err)
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Sequence < rules[j].Sequence
+	})
+
+	order := []string{}
+	for _, rule := range rules {
+		order = append(order, rule.Description)
+	}
+
+	if strings.Join(order, ",") != "web,ssh,dns" {
+		t.Errorf("Expected web,ssh,dns, got %v", order)
+	}
+
+	if item, _ := model.Item(web); item["action"] != "pass" {
+		t.Errorf("Expected web to pass, got %v", item)
+	}
+
+	if _, ok := model.Item(manual); !ok {
+		t.Errorf("Expected the rule outside of the scope to be left alone")
+	}
+
+	plan, err = client.FirewallFilterRuleReconcile(scope, desired, nil)
+	if err != nil || !plan.Empty() {
+		t.Errorf("Expected a second reconciliation to change nothing, got %+v, %v", plan, err)
+	}
+
+	_, err = client.FirewallFilterRulePlan(opnsense.FilterRuleScope{}, desired)
+	if !errors.Is(err, opnsense.ErrOpnsenseInvalidScope) {
+		t.Errorf("Expected empty scope to be refused, got %v", err)
+	}
+
+	_, err = client.FirewallFilterRulePlan(opnsense.FilterRuleScope{DescriptionPrefix: "gitops: "}, desired)
+	if !errors.Is(err, opnsense.ErrOpnsenseOutOfScope) {
+		t.Errorf("Expected rules outside of the scope to be refused, got %v", err)
+	}
+
+	_, err = client.FirewallFilterRulePlan(scope, append(desired, opnsense.FilterRule{Description: "ssh"}))
+	if !errors.Is(err, opnsense.ErrOpnsenseDuplicateRule) {
+		t.Errorf("Expected duplicate descriptions to be refused, got %v", err)
+	}
+}
+
+func TestFilterRuleReconcileFalseBools(t *testing.T) {
+	server, client := newClient(t)
+	model := server.Model("firewall/filter")
+
+	scope := opnsense.FilterRuleScope{DescriptionPrefix: "gitops: "}
+	ssh := model.Insert(map[string]string{"sequence": "100", "description": "gitops: ssh", "log": "1"})
+
+	desired := []opnsense.FilterRule{
+		{Description: "gitops: ssh", Quick: true},
+		{Description: "gitops: dns", Quick: true},
+	}
+
+	plan, err := client.FirewallFilterRulePlan(scope, desired)
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	changes := []string{}
+	for _, change := range plan.Changes[uuid.FromStringOrNil(ssh)] {
+		changes = append(changes, change.String())
+	}
+
+	if strings.Join(changes, ", ") != `enabled: "1" => "0", log: "1" => "0"` {
+		t.Errorf("Expected ssh to be disabled and stop logging, got %v", changes)
+	}
+
+	_, err = client.FirewallFilterRuleReconcile(scope, desired, nil)
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %s", err)
+	}
+
+	if item, _ := model.Item(ssh); item["enabled"] != "0" || item["log"] != "0" || item["quick"] != "1" {
+		t.Errorf("Expected ssh to be disabled without logging, got %v", item)
+	}
+
+	created, err := client.FirewallFilterRuleFindByDescription("gitops: dns")
+	if err != nil || len(created) != 1 || created[0].Enabled || !created[0].Quick {
+		t.Fatalf("Expected dns to be created disabled, got %v, %v", created, err)
+	}
+
+	plan, err = client.FirewallFilterRuleReconcile(scope, desired, nil)
+	if err != nil || !plan.Empty() {
+		t.Errorf("Expected a second reconciliation to change nothing, got %+v, %v", plan, err)
+	}
+}
+
+func TestFilterRulePorts(t *testing.T) {
+	server, client := newClient(t)
+	model := server.Model("firewall/filter")
+
+	single := model.Insert(map[string]string{"destination_port": "443"})
+	model.Insert(map[string]string{"destination_port": "WebPorts", "source_port": "1024-65535"})
+	model.Insert(map[string]string{"destination_port": ""})
+
+	rules, err := client.FirewallFilterRuleSearch()
+	if err != nil {
+		t.Fatalf("Failed to search rules with mixed ports: %s", err)
+	}
+
+	ports := []string{}
+	for _, rule := range rules {
+		ports = append(ports, rule.SourcePort.String()+" "+rule.DestinationPort.String())
+	}
+
+	if strings.Join(ports, ",") != " 443,1024-65535 WebPorts, " {
+		t.Errorf("Unexpected ports: %q", ports)
+	}
+
+	rule, err := client.FirewallFilterRuleGet(uuid.FromStringOrNil(single))
+	if err != nil {
+		t.Fatalf("Failed to get rule: %s", err)
+	}
+
+	rule.DestinationPort = opnsense.NewNamedPort("https")
+
+	err = client.FirewallFilterRuleSet(rule)
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	if item, _ := model.Item(single); item["destination_port"] != "https" || item["source_port"] != "" {
+		t.Errorf("Expected ports to be stored as given, got %v", item)
+	}
+}
+
+func TestFilterRuleReorder(t *testing.T) {
+	server, client := newClient(t)
+	model := server.Model("firewall/filter")
+
+	a := model.Insert(map[string]string{"sequence": "100", "description": "a"})
+	b := model.Insert(map[string]string{"sequence": "200", "description": "b"})
+	c := model.Insert(map[string]string{"sequence": "1000", "description": "c"})
+
+	order := func() string {
+		rules, err := client.FirewallFilterRuleSearch()
+		if err != nil {
+			t.Fatalf("Failed to search rules: %s", err)
+		}
+
+		sort.SliceStable(rules, func(i, j int) bool {
+			return rules[i].Sequence < rules[j].Sequence
+		})
+
+		descriptions := ""
+		for _, rule := range rules {
+			descriptions += rule.Description
+		}
+
+		return descriptions
+	}
+
+	sets := server.Count("firewall/filter/setRule")
+
+	err := client.FirewallFilterRuleMoveBefore(uuid.FromStringOrNil(c), uuid.FromStringOrNil(b))
+	if err != nil {
+		t.Fatalf("Failed to move rule: %s", err)
+	}
+
+	if order() != "acb" || server.Count("firewall/filter/setRule")-sets != 1 {
+		t.Errorf("Expected c to be moved before b with one write, got %s", order())
+	}
+
+	err = client.FirewallFilterRulesReorder([]uuid.UUID{
+		uuid.FromStringOrNil(b), uuid.FromStringOrNil(c), uuid.FromStringOrNil(a),
+	})
+	if err != nil {
+		t.Fatalf("Failed to reorder rules: %s", err)
+	}
+
+	if order() != "bca" {
+		t.Errorf("Expected bca, got %s", order())
+	}
+
+	err = client.FirewallFilterRuleMoveBefore(uuid.NewV4(), uuid.FromStringOrNil(a))
+	if !errors.Is(err, opnsense.ErrOpnsenseNotFound) {
+		t.Errorf("Expected unknown rule to fail, got %v", err)
+	}
+
+	err = client.FirewallFilterRulesReorder([]uuid.UUID{uuid.FromStringOrNil(a), uuid.FromStringOrNil(a)})
+	if !errors.Is(err, opnsense.ErrOpnsenseDuplicateUUID) {
+		t.Errorf("Expected a rule listed twice to fail, got %v", err)
+	}
+}
+
+func TestFilterRuleReorderSubset(t *testing.T) {
+	server, client := newClient(t)
+	model := server.Model("firewall/filter")
+
+	a := model.Insert(map[string]string{"sequence": "1", "description": "a"})
+	model.Insert(map[string]string{"sequence": "2", "description": "x"})
+	b := model.Insert(map[string]string{"sequence": "3", "description": "b"})
+	model.Insert(map[string]string{"sequence": "4", "description": "y"})
+
+	err := client.FirewallFilterRulesReorder([]uuid.UUID{uuid.FromStringOrNil(b), uuid.FromStringOrNil(a)})
+	if err != nil {
+		t.Fatalf("Failed to reorder rules: %s", err)
+	}
+
+	rules, err := client.FirewallFilterRuleSearch()
+	if err != nil {
+		t.Fatalf("Failed to search rules: %s", err)
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Sequence < rules[j].Sequence
+	})
+
+	descriptions := ""
+	for _, rule := range rules {
+		descriptions += rule.Description
+	}
+
+	if descriptions != "bxay" {
+		t.Errorf("Expected b and a to swap places around x, got %s", descriptions)
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	server, client := newClient(t)
+
+	server.SetInterface(opnsensetest.Interface{
+		Identifier:  "opt1",
+		Device:      "vtnet2",
+		Description: "DMZ",
+		Down:        true,
+		IPv6:        []string{"2001:db8::1/64"},
+	})
+
+	interfaces, err := client.InterfacesList()
+	if err != nil {
+		t.Fatalf("Failed to list interfaces: %s", err)
+	}
+
+	if len(interfaces) != 3 {
+		t.Fatalf("Expected 3 interfaces, got %d", len(interfaces))
+	}
+
+	dmz := interfaces[2]
+	if dmz.Identifier != "opt1" || dmz.Device != "vtnet2" || dmz.Status != "down" ||
+		len(dmz.IPv6) != 1 || dmz.IPv6[0].Address != "2001:db8::1/64" {
+		t.Errorf("Unexpected interface: %#v", dmz)
+	}
+
+	group := opnsense.InterfaceGroup{Name: "internal", Members: "lan,opt1"}
+
+	id, err := client.InterfaceGroupAdd(&group)
+	if err != nil {
+		t.Fatalf("Failed to add group: %s", err)
+	}
+
+	got, err := client.InterfaceGroupGet(*id)
+	if err != nil || got.Members != "lan,opt1" {
+		t.Errorf("Unexpected group: %#v, %v", got, err)
+	}
+
+	err = client.InterfaceGroupReconfigure()
+	if err != nil {
+		t.Fatalf("Failed to reconfigure groups: %s", err)
+	}
+
+	for iface, valid := range map[opnsense.Interface]bool{
+		"":               true,
+		"lan":            true,
+		"lan,internal":   true,
+		"opt2":           false,
+		"lan,wireguard0": false,
+	} {
+		err := client.InterfacesValidate(iface)
+		if valid != (err == nil) || (!valid && !errors.Is(err, opnsense.ErrOpnsenseUnknownInterface)) {
+			t.Errorf("Unexpected validation of %q: %v", iface, err)
+		}
+	}
+
+	_, err = client.FirewallFilterRuleAdd(&opnsense.FilterRule{Interface: "opt2"})
+	if err != nil {
+		t.Errorf("Expected rules not to be validated by default, got %s", err)
+	}
+
+	validating, err := server.Client(opnsense.WithInterfaceValidation())
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	_, err = validating.FirewallFilterRuleAdd(&opnsense.FilterRule{Interface: "opt2"})
+	if !errors.Is(err, opnsense.ErrOpnsenseUnknownInterface) {
+		t.Errorf("Expected unknown interface to be refused, got %v", err)
+	}
+
+	_, err = validating.FirewallSourceNatRuleAdd(&opnsense.SourceNatRule{Interface: "internal"})
+	if err != nil {
+		t.Errorf("Expected interface group to be accepted, got %s", err)
+	}
+
+	_, err = validating.FirewallNptRuleAdd(&opnsense.NptRule{Interface: "wan", SourceNet: "fd00:1::/64", TrackInterface: "opt2"})
+	if !errors.Is(err, opnsense.ErrOpnsenseUnknownInterface) {
+		t.Errorf("Expected unknown track interface to be refused, got %v", err)
+	}
+
+	got.Members = "lan,opt3"
+
+	err = validating.InterfaceGroupSet(got)
+	if !errors.Is(err, opnsense.ErrOpnsenseUnknownInterface) {
+		t.Errorf("Expected unknown group member to be refused, got %v", err)
+	}
+
+	err = client.InterfaceGroupDelete(*id)
+	if err != nil {
+		t.Fatalf("Failed to delete group: %s", err)
+	}
+}
+
+func TestSourceNatRule(t *testing.T) {
+	server, client := newClient(t)
+
+	rule := opnsense.SourceNatRule{
+		Enabled:     true,
+		Interface:   "wan,opt1",
+		SourceNet:   "10.0.0.0/24",
+		Target:      "192.0.2.1",
+		Description: "masquerade lan",
+	}
+
+	id, err := client.FirewallSourceNatRuleAdd(&rule)
+	if err != nil {
+		t.Fatalf("Failed to add rule: %s", err)
+	}
+
+	if rule.UUID == nil || !uuid.Equal(*rule.UUID, *id) {
+		t.Errorf("Expected rule UUID to be set to %s, got %v", id, rule.UUID)
+	}
+
+	got, err := client.FirewallSourceNatRuleGet(*id)
+	if err != nil {
+		t.Fatalf("Failed to get rule: %s", err)
+	}
+
+	if got.Interface != "opt1,wan" || got.Protocol != "any" || got.IPProtocol != opnsense.IPProtocolInet ||
+		got.Target != "192.0.2.1" || got.NoNat {
+		t.Errorf("Unexpected rule: %#v", got)
+	}
+
+	got.NoNat = true
+
+	err = client.FirewallSourceNatRuleSet(got)
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	stored, _ := server.Model("firewall/source_nat").Item(id.String())
+	if stored["nonat"] != "1" {
+		t.Errorf("Expected rule to be stored without NAT, got %v", stored)
+	}
+
+	err = client.FirewallSourceNatRuleSet(&opnsense.SourceNatRule{})
+	if !errors.Is(err, opnsense.ErrOpnsenseMissingUUID) {
+		t.Errorf("Expected missing UUID error, got %v", err)
+	}
+
+	_, err = client.FirewallSourceNatRuleToggle(*id, false)
+	if err != nil {
+		t.Fatalf("Failed to toggle rule: %s", err)
+	}
+
+	rules, err := client.FirewallSourceNatRuleSearch()
+	if err != nil || len(rules) != 1 || rules[0].Enabled {
+		t.Errorf("Unexpected rules: %v, %v", rules, err)
+	}
+
+	err = client.FirewallSourceNatRuleDelete(*id)
+	if err != nil {
+		t.Fatalf("Failed to delete rule: %s", err)
+	}
+
+	if server.Model("firewall/source_nat").Len() != 0 {
+		t.Errorf("Expected no rules left")
+	}
+}
+
+func TestDestinationNatRule(t *testing.T) {
+	server, client := newClient(t)
+
+	rule := opnsense.DestinationNatRule{
+		Interface:      "wan",
+		Protocol:       "TCP",
+		DestinationNet: "wanip",
+		Target:         "10.0.0.10",
+		Description:    "web",
+	}
+
+	filterRule, err := client.FirewallDestinationNatRuleAddWithFilterRule(&rule)
+	if err != nil {
+		t.Fatalf("Failed to add rule: %s", err)
+	}
+
+	if rule.UUID == nil || filterRule.UUID == nil {
+		t.Fatalf("Expected both rules to have UUIDs, got %v and %v", rule.UUID, filterRule.UUID)
+	}
+
+	passed, err := client.FirewallFilterRuleGet(*filterRule.UUID)
+	if err != nil {
+		t.Fatalf("Failed to get filter rule: %s", err)
+	}
+
+	if passed.DestinationNet != "10.0.0.10" || passed.Action != opnsense.ActionPass || passed.Description != "NAT web" {
+		t.Errorf("Unexpected filter rule: %#v", passed)
+	}
+
+	stored, _ := server.Model("firewall/d_nat").Item(rule.UUID.String())
+	if stored["destination.network"] != "wanip" || stored["source.network"] != "any" || stored["descr"] != "web" {
+		t.Errorf("Expected the source and destination to be nested, got %v", stored)
+	}
+
+	associated := opnsense.DestinationNatRule{
+		Target:                "10.0.0.11",
+		FilterRuleAssociation: opnsense.FilterRuleAssociated,
+	}
+
+	_, err = client.FirewallDestinationNatRuleAddWithFilterRule(&associated)
+	if !errors.Is(err, opnsense.ErrOpnsenseInvalidOption) {
+		t.Errorf("Expected an associated rule to be refused, got %v", err)
+	}
+
+	id, err := client.FirewallDestinationNatRuleAdd(&associated)
+	if err != nil {
+		t.Fatalf("Failed to add rule: %s", err)
+	}
+
+	got, err := client.FirewallDestinationNatRuleGet(*id)
+	if err != nil {
+		t.Fatalf("Failed to get rule: %s", err)
+	}
+
+	if got.FilterRuleAssociation != opnsense.FilterRuleAssociated || got.Target != "10.0.0.11" || got.Disabled {
+		t.Errorf("Unexpected rule: %#v", got)
+	}
+
+	_, err = client.FirewallDestinationNatRuleToggle(*id, false)
+	if err != nil {
+		t.Fatalf("Failed to toggle rule: %s", err)
+	}
+
+	stored, _ = server.Model("firewall/d_nat").Item(id.String())
+	if stored["disabled"] != "1" {
+		t.Errorf("Expected rule to be disabled, got %v", stored)
+	}
+
+	got.Description = "associated"
+
+	err = client.FirewallDestinationNatRuleSet(got)
+	if err != nil {
+		t.Fatalf("Failed to set rule: %s", err)
+	}
+
+	rules, err := client.FirewallDestinationNatRuleSearch()
+	if err != nil || len(rules) != 2 || rules[0].DestinationNet != "wanip" || rules[0].Description != "web" {
+		t.Fatalf("Unexpected rules: %v, %v", rules, err)
+	}
+
+	stored, _ = server.Model("firewall/d_nat").Item(id.String())
+	if stored["disabled"] != "0" || stored["descr"] != "associated" {
+		t.Errorf("Expected the set to overwrite the toggle, got %v", stored)
+	}
+
+	err = client.FirewallDestinationNatRuleDelete(*id)
+	if err != nil {
+		t.Fatalf("Failed to delete rule: %s", err)
+	}
+
+	if server.Model("firewall/d_nat").Len() != 1 {
+		t.Errorf("Expected one rule left")
+	}
+}
+
+func TestDestinationNatRuleAddWithFilterRuleDisabled(t *testing.T) {
+	server, client := newClient(t)
+
+	rule := opnsense.DestinationNatRule{Disabled: true, Target: "10.0.0.10", Description: "web"}
+
+	filterRule, err := client.FirewallDestinationNatRuleAddWithFilterRule(&rule)
+	if err != nil {
+		t.Fatalf("Failed to add rule: %s", err)
+	}
+
+	stored, _ := server.Model("firewall/filter").Item(filterRule.UUID.String())
+	if stored["enabled"] != "0" {
+		t.Errorf("Expected the filter rule of a disabled port forward to be disabled, got %v", stored)
+	}
+}
+
+func TestDestinationNatRuleAddWithFilterRuleFails(t *testing.T) {
+	server, client := newClient(t)
+
+	server.Handle("firewall/filter/addRule", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"failed","validations":{"rule.description":"invalid"}}`))
+	})
+
+	rule := opnsense.DestinationNatRule{Target: "10.0.0.10", Description: "web"}
+
+	_, err := client.FirewallDestinationNatRuleAddWithFilterRule(&rule)
+	if err == nil {
+		t.Fatalf("Expected the failed filter rule to be reported")
+	}
+
+	if rule.UUID != nil || server.Model("firewall/d_nat").Len() != 0 {
+		t.Errorf("Expected the NAT rule to be deleted again, got %v and %d rules", rule.UUID, server.Model("firewall/d_nat").Len())
+	}
+
+	server.Handle("firewall/d_nat/delRule", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"failed"}`))
+	})
+
+	_, err = client.FirewallDestinationNatRuleAddWithFilterRule(&rule)
+	if err == nil || !strings.Contains(err.Error(), "FirewallDestinationNatRuleDelete failed") {
+		t.Fatalf("Expected the failed delete to be reported, got %v", err)
+	}
+
+	if rule.UUID == nil || server.Model("firewall/d_nat").Len() != 1 {
+		t.Errorf("Expected the UUID of the remaining NAT rule, got %v", rule.UUID)
+	}
+}
+
+func TestOneToOneAndNptRules(t *testing.T) {
+	server, client := newClient(t)
+
+	_, err := client.FirewallOneToOneRuleAdd(&opnsense.OneToOneRule{Type: "redirect"})
+	if !errors.Is(err, opnsense.ErrOpnsenseInvalidOption) {
+		t.Errorf("Expected invalid type to be refused, got %v", err)
+	}
+
+	binat := opnsense.OneToOneRule{
+		Enabled:   true,
+		SourceNet: "10.0.0.5",
+		External:  "192.0.2.5",
+	}
+
+	id, err := client.FirewallOneToOneRuleAdd(&binat)
+	if err != nil {
+		t.Fatalf("Failed to add one-to-one rule: %s", err)
+	}
+
+	got, err := client.FirewallOneToOneRuleGet(*id)
+	if err != nil || got.Type != opnsense.OneToOneBinat || got.External != "192.0.2.5" {
+		t.Errorf("Unexpected one-to-one rule: %#v, %v", got, err)
+	}
+
+	got.Type = opnsense.OneToOneNat
+
+	err = client.FirewallOneToOneRuleSet(got)
+	if err != nil {
+		t.Fatalf("Failed to set one-to-one rule: %s", err)
+	}
+
+	rules, err := client.FirewallOneToOneRuleSearch()
+	if err != nil || len(rules) != 1 || rules[0].Type != opnsense.OneToOneNat {
+		t.Errorf("Unexpected one-to-one rules: %v, %v", rules, err)
+	}
+
+	_, err = client.FirewallNptRuleAdd(&opnsense.NptRule{})
+	if err == nil {
+		t.Errorf("Expected NPT rule without internal prefix to fail")
+	}
+
+	for _, invalid := range []opnsense.NptRule{
+		{SourceNet: "10.0.0.0/24"},
+		{SourceNet: "fd00:1::/64", DestinationNet: "2001:db8::/48"},
+		{SourceNet: "fd00:1::/64", TrackInterface: "wan,opt1"},
+	} {
+		_, err = client.FirewallNptRuleAdd(&invalid)
+		if !errors.Is(err, opnsense.ErrOpnsenseInvalidOption) {
+			t.Errorf("Expected %#v to be refused, got %v", invalid, err)
+		}
+	}
+
+	npt := opnsense.NptRule{
+		Enabled:        true,
+		Interface:      "wan",
+		SourceNet:      "fd00:1::/64",
+		TrackInterface: "wan",
+	}
+
+	nptID, err := client.FirewallNptRuleAdd(&npt)
+	if err != nil {
+		t.Fatalf("Failed to add NPT rule: %s", err)
+	}
+
+	_, err = client.FirewallNptRuleToggle(*nptID, false)
+	if err != nil {
+		t.Fatalf("Failed to toggle NPT rule: %s", err)
+	}
+
+	nptRules, err := client.FirewallNptRuleSearch()
+	if err != nil || len(nptRules) != 1 || nptRules[0].Enabled || nptRules[0].TrackInterface != "wan" {
+		t.Errorf("Unexpected NPT rules: %v, %v", nptRules, err)
+	}
+
+	err = client.FirewallNptRuleDelete(*nptID)
+	if err != nil {
+		t.Fatalf("Failed to delete NPT rule: %s", err)
+	}
+
+	err = client.FirewallOneToOneRuleDelete(*id)
+	if err != nil {
+		t.Fatalf("Failed to delete one-to-one rule: %s", err)
+	}
+
+	if server.Model("firewall/npt").Len() != 0 || server.Model("firewall/one_to_one").Len() != 0 {
+		t.Errorf("Expected no rules left")
+	}
+}
+
+func TestBgpNeighbor(t *testing.T) {
+	_, client := newClient(t)
+
+	set := opnsense.BgpNeighborSet{
+		BgpNeighborBase: opnsense.BgpNeighborBase{
+			Enabled:  true,
+			Address:  "10.0.0.1",
+			Remoteas: "65001",
+		},
+		Updatesource: "lan",
+	}
+
+	id, err := client.BgpNeighborAdd(set)
+	if err != nil {
+		t.Fatalf("Failed to add neighbor: %s", err)
+	}
+
+	neighbors, err := client.BgpNeighborList()
+	if err != nil || len(neighbors) != 1 {
+		t.Fatalf("Expected one neighbor, got %v, %v", neighbors, err)
+	}
+
+	if !uuid.Equal(*neighbors[0].UUID, *id) || neighbors[0].Updatesource["lan"].Selected != 1 {
+		t.Errorf("Unexpected neighbor: %#v", neighbors[0])
+	}
+
+	_, err = client.BgpNeighborAdd(opnsense.BgpNeighborSet{})
+	if !errors.Is(err, opnsense.ErrOpnsenseSave) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
+
+func TestWireGuard(t *testing.T) {
+	_, client := newClient(t)
+
+	err := client.WireGuardEnableService()
+	if err != nil {
+		t.Fatalf("Failed to enable WireGuard: %s", err)
+	}
+
+	settings, err := client.WireGuardSettingsGet()
+	if err != nil || settings.General.Enabled != "1" {
+		t.Errorf("Expected WireGuard to be enabled, got %v, %v", settings, err)
+	}
+
+	server := opnsense.WireGuardServerSet{
+		WireGuardServerBase: opnsense.WireGuardServerBase{
+			Enabled: true,
+			Name:    "wg0",
+			Port:    "51820",
+		},
+		TunnelAddress: "10.10.0.1/24",
+	}
+
+	id, err := client.WireGuardServerAdd(server)
+	if err != nil {
+		t.Fatalf("Failed to add server: %s", err)
+	}
+
+	ids, err := client.WireGuardServerFindUUIDByName("wg0")
+	if err != nil || len(ids) != 1 || !uuid.Equal(*ids[0], *id) {
+		t.Fatalf("Expected to find server, got %v, %v", ids, err)
+	}
+
+	got, err := client.WireGuardServerGet(*ids[0])
+	if err != nil || got.TunnelAddress["10.10.0.1/24"].Selected != 1 {
+		t.Errorf("Unexpected server: %#v, %v", got, err)
+	}
+}
+
+func TestFirmwareAndBackup(t *testing.T) {
+	server, client := newClient(t)
+
+	err := client.FirmwareInstall("os-haproxy")
+	if err != nil {
+		t.Fatalf("Failed to install plugin: %s", err)
+	}
+
+	plugins, err := client.FirmwareInstalledPluginsList()
+	if err != nil {
+		t.Fatalf("Failed to list plugins: %s", err)
+	}
+
+	if len(plugins) != len(server.Plugins()) {
+		t.Errorf("Expected plugins %v, got %v", server.Plugins(), plugins)
+	}
+
+	server.SetBackup("<opnsense><secret/></opnsense>")
+
+	backup, err := client.Backup()
+	if err != nil || backup != "<opnsense><secret/></opnsense>" {
+		t.Errorf("Unexpected backup: %q, %v", backup, err)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	server, client := newClient(t)
+
+	server.SetCredentials("other", "credentials")
+
+	_, err := client.FirmwareStatus()
+	if !errors.Is(err, opnsense.ErrOpnsense401) {
+		t.Errorf("Expected authentication error, got %v", err)
+	}
+
+	_, err = client.AliasGetList()
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("Expected authentication error, got %v", err)
+	}
+}
+
+func TestMissingPlugin(t *testing.T) {
+	_, client := newClient(t)
+
+	err := client.GetAndUnmarshal("haproxy/settings/get", &struct{}{})
+	if !errors.Is(err, opnsense.ErrOpnsensePluginMissing) {
+		t.Errorf("Expected missing plugin error, got %v", err)
+	}
+}
@@ -0,0 +1,222 @@
+package opnsense
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+const DefaultTimeout = 60 * time.Second
+
+var (
+	ErrOpnsenseMissingBaseURL    = errors.New("no base URL configured")
+	ErrOpnsenseInvalidCABundle   = errors.New("no certificates found in CA bundle")
+	ErrOpnsenseConflictingOption = errors.New("TLS and timeout options cannot be combined with a custom HTTP client")
+)
+
+// Option configures a Client created by New.
+type Option func(*clientConfig) error
+
+type clientConfig struct {
+	baseURL            string
+	key                string
+	secret             string
+	insecureSkipVerify bool
+	rootCAs            *x509.CertPool
+	timeout            *time.Duration
+	httpClient         *http.Client
+	userAgent          string
+	logger             *slog.Logger
+	retryPolicy        RetryPolicy
+	limiter            *rateLimiter
+	inFlight           chan struct{}
+	validateInterfaces bool
+	// allowEmptyBaseURL keeps NewClient accepting an empty base URL, as it
+	// did before New existed.
+	allowEmptyBaseURL bool
+}
+
+// New creates a Client from the given options. Unlike NewClient it never
+// consults the environment on its own, add FromEnv to the options for that.
+func New(opts ...Option) (*Client, error) {
+	cfg := clientConfig{}
+
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.baseURL == "" && !cfg.allowEmptyBaseURL {
+		return nil, ErrOpnsenseMissingBaseURL
+	}
+
+	baseURL, err := url.Parse(cfg.baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := cfg.buildHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		baseURL:   baseURL,
+		key:       cfg.key,
+		secret:    cfg.secret,
+		userAgent: cfg.userAgent,
+		logger:    newRedactingLogger(cfg.logger),
+		retry:     cfg.retryPolicy,
+		limiter:   cfg.limiter,
+		inFlight:  cfg.inFlight,
+		c:         httpClient,
+
+		validateInterfaces: cfg.validateInterfaces,
+	}, nil
+}
+
+func (cfg *clientConfig) buildHTTPClient() (*http.Client, error) {
+	if cfg.httpClient != nil {
+		if cfg.insecureSkipVerify || cfg.rootCAs != nil || cfg.timeout != nil {
+			return nil, ErrOpnsenseConflictingOption
+		}
+
+		return cfg.httpClient, nil
+	}
+
+	timeout := DefaultTimeout
+	if cfg.timeout != nil {
+		timeout = *cfg.timeout
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			/* #nosec */
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: cfg.insecureSkipVerify,
+				RootCAs:            cfg.rootCAs,
+			},
+		},
+	}, nil
+}
+
+// WithBaseURL sets the URL of the OPNsense web interface, e.g.
+// https://192.168.1.1. The /api prefix is added by the client.
+func WithBaseURL(baseURL string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.baseURL = baseURL
+
+		return nil
+	}
+}
+
+// WithCredentials sets the API key and secret used for basic authentication.
+func WithCredentials(key, secret string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.key = key
+		cfg.secret = secret
+
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the firewall's TLS
+// certificate. Prefer WithCABundle for self-signed certificates.
+func WithInsecureSkipVerify(insecure bool) Option {
+	return func(cfg *clientConfig) error {
+		cfg.insecureSkipVerify = insecure
+
+		return nil
+	}
+}
+
+// WithCABundle trusts the PEM encoded certificates in bundle instead of
+// the system roots when verifying the firewall's certificate.
+func WithCABundle(bundle []byte) Option {
+	return func(cfg *clientConfig) error {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bundle) {
+			return ErrOpnsenseInvalidCABundle
+		}
+
+		cfg.rootCAs = pool
+
+		return nil
+	}
+}
+
+// WithCABundleFile is like WithCABundle but reads the bundle from a file.
+func WithCABundleFile(path string) Option {
+	return func(cfg *clientConfig) error {
+		bundle, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		return WithCABundle(bundle)(cfg)
+	}
+}
+
+// WithTimeout overrides the DefaultTimeout of the HTTP client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *clientConfig) error {
+		cfg.timeout = &timeout
+
+		return nil
+	}
+}
+
+// WithHTTPClient makes the client send all requests through httpClient.
+// It cannot be combined with the TLS and timeout options, configure those
+// on httpClient directly.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *clientConfig) error {
+		cfg.httpClient = httpClient
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.userAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithLogger sets the logger the client reports requests and failures to.
+// Without it the client is silent. Secrets are redacted before records are
+// passed on to the logger's handler.
+func WithLogger(logger *slog.Logger) Option {
+	return func(cfg *clientConfig) error {
+		cfg.logger = logger
+
+		return nil
+	}
+}
+
+// FromEnv reads OPNSENSE_URL, OPNSENSE_KEY, OPNSENSE_SECRET and
+// OPNSENSE_ALLOW_UNVERIFIED_TLS. Variables that are not set leave the
+// current configuration alone, so options placed after FromEnv take
+// precedence over the environment and options placed before it do not.
+// Only the environment of the process is read; unlike earlier versions, a
+// .env file in the working directory is not loaded.
+func FromEnv() Option {
+	return func(cfg *clientConfig) error {
+		cfg.baseURL = getEnv("OPNSENSE_URL", cfg.baseURL)
+		cfg.key = getEnv("OPNSENSE_KEY", cfg.key)
+		cfg.secret = getEnv("OPNSENSE_SECRET", cfg.secret)
+		cfg.insecureSkipVerify = getEnvAsBool("OPNSENSE_ALLOW_UNVERIFIED_TLS", cfg.insecureSkipVerify)
+
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package opnsense
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewIgnoresEnvironment(t *testing.T) {
+	t.Setenv("OPNSENSE_URL", "https://env.example")
+	t.Setenv("OPNSENSE_KEY", "envkey")
+
+	client, err := New(WithBaseURL("https://arg.example"), WithCredentials("key", "secret"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	if client.baseURL.String() != "https://arg.example" || client.key != "key" {
+		t.Errorf("Expected explicit options to be used, got %s and %s", client.baseURL, client.key)
+	}
+}
+
+func TestFromEnvPrecedence(t *testing.T) {
+	t.Setenv("OPNSENSE_URL", "https://env.example")
+	t.Setenv("OPNSENSE_KEY", "envkey")
+
+	client, err := New(FromEnv(), WithCredentials("key", "secret"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	if client.baseURL.String() != "https://env.example" {
+		t.Errorf("Expected URL from environment, got %s", client.baseURL)
+	}
+
+	if client.key != "key" {
+		t.Errorf("Expected key from later option, got %s", client.key)
+	}
+}
+
+func TestNewClientAcceptsEmptyBaseURL(t *testing.T) {
+	t.Setenv("OPNSENSE_URL", "")
+
+	client, err := NewClient("", "key", "secret", false)
+	if err != nil {
+		t.Fatalf("Expected NewClient to accept an empty base URL, got %s", err)
+	}
+
+	if client.baseURL.String() != "" {
+		t.Errorf("Expected empty base URL, got %s", client.baseURL)
+	}
+}
+
+func TestNewOptionErrors(t *testing.T) {
+	_, err := New()
+	if !errors.Is(err, ErrOpnsenseMissingBaseURL) {
+		t.Errorf("Expected missing base URL error, got %v", err)
+	}
+
+	_, err = New(WithBaseURL("https://a.example"), WithCABundle([]byte("not a certificate")))
+	if !errors.Is(err, ErrOpnsenseInvalidCABundle) {
+		t.Errorf("Expected invalid CA bundle error, got %v", err)
+	}
+
+	_, err = New(
+		WithBaseURL("https://a.example"),
+		WithHTTPClient(&http.Client{}),
+		WithTimeout(time.Second),
+	)
+	if !errors.Is(err, ErrOpnsenseConflictingOption) {
+		t.Errorf("Expected conflicting option error, got %v", err)
+	}
+}
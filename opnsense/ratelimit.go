@@ -0,0 +1,140 @@
+package opnsense
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits the client to requestsPerSecond on average with bursts
+// of up to burst requests. The limit is shared by everything using the same
+// Client, so the List helpers can be run concurrently without overloading
+// the PHP workers of a small appliance.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(cfg *clientConfig) error {
+		cfg.limiter = newRateLimiter(requestsPerSecond, burst)
+
+		return nil
+	}
+}
+
+// WithMaxInFlight caps the number of requests the client has outstanding at
+// any time. A request counts until its response body has been closed.
+func WithMaxInFlight(n int) Option {
+	return func(cfg *clientConfig) error {
+		if n > 0 {
+			cfg.inFlight = make(chan struct{}, n)
+		}
+
+		return nil
+	}
+}
+
+// send waits for the rate limiter and a free request slot before handing
+// request to the HTTP client.
+func (c *Client) send(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	if c.limiter != nil {
+		err := c.limiter.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.inFlight == nil {
+		return c.c.Do(request)
+	}
+
+	select {
+	case c.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	release := func() { <-c.inFlight }
+
+	resp, err := c.c.Do(request)
+	if err != nil {
+		release()
+
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
+
+// rateLimiter is a token bucket holding up to burst tokens, refilled at rate
+// tokens per second.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, sleeping until one is available or
+// ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		l.last = now
+
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		err := sleepCtx(ctx, delay)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package opnsense
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxInFlight(t *testing.T) {
+	var current, highest int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for {
+			h := atomic.LoadInt32(&highest)
+			if n <= h || atomic.CompareAndSwapInt32(&highest, h, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(WithBaseURL(server.URL), WithMaxInFlight(2))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.FirmwareStatus()
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if highest > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", highest)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(WithBaseURL(server.URL), WithRateLimit(100, 1))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		_, err := client.FirmwareStatus()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("Expected 5 requests at 100/s to take at least 40ms, took %s", elapsed)
+	}
+}
@@ -0,0 +1,241 @@
+package opnsense
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ResourceConfig describes a model exposed by an OPNsense MVC API
+// controller through the usual get/search/add/set/del/toggle actions.
+type ResourceConfig struct {
+	// Module is the path of the controller, e.g. firewall/alias.
+	Module string
+	// Key is the name the item is wrapped in, e.g. alias for {"alias": {...}}.
+	Key string
+	// Suffix is appended to the action names. Item results in getItem,
+	// searchItem, etc., client results in getclient, searchclient, etc.
+	Suffix string
+	// Reconfigure is the endpoint that makes saved changes live, e.g.
+	// firewall/alias/reconfigure.
+	Reconfigure string
+}
+
+// Resource implements the CRUD actions for a single model. G is the type
+// returned by get, where option fields are maps of all choices with the
+// selected ones marked, and S is the type accepted by add and set, where the
+// same fields are plain strings. Models that marshal symmetrically use the
+// same type for both.
+type Resource[G any, S any] struct {
+	client *Client
+	config ResourceConfig
+}
+
+func NewResource[G any, S any](client *Client, config ResourceConfig) *Resource[G, S] {
+	return &Resource[G, S]{
+		client: client,
+		config: config,
+	}
+}
+
+// uuidSetter is implemented by items that carry their UUID. OPNsense does
+// not include it in the response of get, so it is filled in from the request.
+type uuidSetter interface {
+	setUUID(id uuid.UUID)
+}
+
+func (r *Resource[G, S]) endpoint(action string, args ...string) string {
+	return path.Join(append([]string{r.config.Module, action + r.config.Suffix}, args...)...)
+}
+
+func (r *Resource[G, S]) Get(ctx context.Context, id uuid.UUID) (*G, error) {
+	api := r.endpoint("get", id.String())
+
+	var response map[string]*G
+
+	err := r.client.GetAndUnmarshalCtx(ctx, api, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	item := response[r.config.Key]
+	if item == nil {
+		return nil, ErrOpnsenseEmptyListNotFound
+	}
+
+	if setter, ok := interface{}(item).(uuidSetter); ok {
+		setter.setUUID(id)
+	}
+
+	return item, nil
+}
+
+func (r *Resource[G, S]) searchEndpoint() string {
+	return r.endpoint("search")
+}
+
+// Search fetches a single page of rows described by opts.
+func (r *Resource[G, S]) Search(ctx context.Context, opts SearchOptions) (*SearchResult, error) {
+	page, err := Search[interface{}](ctx, r.client, r.searchEndpoint(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchResult{
+		Rows:     page.Rows,
+		RowCount: page.RowCount,
+		Total:    page.Total,
+		Current:  page.Current,
+	}, nil
+}
+
+// UUIDs returns the UUID of every item, walking all pages of search.
+func (r *Resource[G, S]) UUIDs(ctx context.Context) ([]*uuid.UUID, error) {
+	type Row struct {
+		UUID string `json:"uuid"`
+	}
+
+	rows, err := collect(SearchAll[Row](ctx, r.client, r.searchEndpoint(), SearchOptions{}))
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := []*uuid.UUID{}
+
+	for _, row := range rows {
+		id, err := uuid.FromString(row.UUID)
+		if err == nil {
+			uuids = append(uuids, &id)
+		}
+	}
+
+	return uuids, nil
+}
+
+// List fetches every item. Items deleted between the search and fetching
+// them are skipped, any other error is returned.
+func (r *Resource[G, S]) List(ctx context.Context) ([]*G, error) {
+	uuids, err := r.UUIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*G{}
+
+	for _, id := range uuids {
+		item, err := r.Get(ctx, *id)
+		if errors.Is(err, ErrOpnsenseEmptyListNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (r *Resource[G, S]) Add(ctx context.Context, item S) (*GenericResponse, error) {
+	api := r.endpoint("add")
+
+	return r.save(ctx, api, item)
+}
+
+func (r *Resource[G, S]) Set(ctx context.Context, id uuid.UUID, item S) (*GenericResponse, error) {
+	api := r.endpoint("set", id.String())
+
+	return r.save(ctx, api, item)
+}
+
+func (r *Resource[G, S]) Delete(ctx context.Context, id uuid.UUID) (*GenericResponse, error) {
+	api := r.endpoint("del", id.String())
+
+	var response GenericResponse
+
+	err := r.client.PostAndMarshalCtx(ctx, api, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Result != StatusDeleted {
+		r.client.logger.DebugContext(ctx, "delete response", "api", api, "response", response)
+
+		return nil, newResultError(api, &response, ErrOpnsenseDelete)
+	}
+
+	return &response, nil
+}
+
+// Toggle enables or disables an item. The result is Enabled or Disabled on
+// success.
+func (r *Resource[G, S]) Toggle(ctx context.Context, id uuid.UUID, enabled Bool) (*GenericResponse, error) {
+	api := r.endpoint("toggle", id.String(), enabled.URLArgument())
+
+	var response GenericResponse
+
+	err := r.client.PostAndMarshalCtx(ctx, api, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Result == StatusFailed {
+		r.client.logger.DebugContext(ctx, "toggle response", "api", api, "response", response)
+
+		return nil, newResultError(api, &response, ErrOpnsenseSave)
+	}
+
+	return &response, nil
+}
+
+// Reconfigure applies the saved configuration of the model.
+func (r *Resource[G, S]) Reconfigure(ctx context.Context) error {
+	api := r.config.Reconfigure
+
+	var response StatusMessage
+
+	err := r.client.PostAndMarshalCtx(ctx, api, map[string]interface{}{}, &response)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(response.Status), StatusOK) {
+		r.client.logger.DebugContext(ctx, "reconfigure response", "api", api, "response", response)
+
+		return &APIError{
+			Method:     http.MethodPost,
+			Endpoint:   api,
+			StatusCode: http.StatusOK,
+			Err:        ErrOpnsenseStatusNotOk,
+		}
+	}
+
+	return nil
+}
+
+func (r *Resource[G, S]) save(ctx context.Context, api string, item S) (*GenericResponse, error) {
+	request := map[string]interface{}{
+		r.config.Key: item,
+	}
+
+	var response GenericResponse
+
+	err := r.client.PostAndMarshalCtx(ctx, api, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Result != StatusSaved {
+		r.client.logger.DebugContext(ctx, "save response", "api", api, "response", response)
+
+		return nil, newResultError(api, &response, ErrOpnsenseSave)
+	}
+
+	return &response, nil
+}
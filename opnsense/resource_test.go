@@ -0,0 +1,113 @@
+package opnsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestResourceEndpoints(t *testing.T) {
+	id := uuid.NewV4()
+	requests := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/api/quagga/bgp/getNeighbor/" + id.String():
+			_, _ = w.Write([]byte(`{"neighbor":{"enabled":"1","address":"10.0.0.1","updatesource":[]}}`))
+		case "/api/quagga/bgp/searchNeighbor":
+			_, _ = w.Write([]byte(`{"rows":[{"uuid":"` + id.String() + `"}],"rowCount":1,"total":1,"current":1}`))
+		case "/api/quagga/bgp/addNeighbor":
+			_ = json.NewEncoder(w).Encode(GenericResponse{Result: StatusSaved, UUID: &id})
+		case "/api/quagga/bgp/delNeighbor/" + id.String():
+			_, _ = w.Write([]byte(`{"result":"not found"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	neighbors, err := client.BgpNeighborList()
+	if err != nil || len(neighbors) != 1 {
+		t.Fatalf("Expected one neighbor, got %v, %v", neighbors, err)
+	}
+
+	if !uuid.Equal(*neighbors[0].UUID, id) || neighbors[0].Address != "10.0.0.1" {
+		t.Errorf("Unexpected neighbor: %#v", neighbors[0])
+	}
+
+	added, err := client.BgpNeighborAdd(BgpNeighborSet{})
+	if err != nil || !uuid.Equal(*added, id) {
+		t.Errorf("Expected added neighbor to return its UUID, got %v, %v", added, err)
+	}
+
+	_, err = client.BgpNeighborDelete(id)
+	if !errors.Is(err, ErrOpnsenseDelete) {
+		t.Errorf("Expected delete error, got %v", err)
+	}
+
+	_, err = NewResource[FilterRule, FilterRule](client, ResourceConfig{
+		Module: "firewall/missing",
+		Key:    "rule",
+		Suffix: "Rule",
+	}).Get(context.Background(), id)
+	if !errors.Is(err, ErrOpnsensePluginMissing) {
+		t.Errorf("Expected missing plugin error, got %v", err)
+	}
+
+	expected := []string{
+		"POST /api/quagga/bgp/searchNeighbor",
+		"GET /api/quagga/bgp/getNeighbor/" + id.String(),
+		"POST /api/quagga/bgp/addNeighbor",
+		"POST /api/quagga/bgp/delNeighbor/" + id.String(),
+		"GET /api/firewall/missing/getRule/" + id.String(),
+	}
+
+	if len(requests) != len(expected) {
+		t.Fatalf("Unexpected requests: %v", requests)
+	}
+
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Expected request %s, got %s", expected[i], requests[i])
+		}
+	}
+}
+
+func TestResourceListErrors(t *testing.T) {
+	deleted, broken := uuid.NewV4(), uuid.NewV4()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/quagga/bgp/searchNeighbor":
+			_, _ = w.Write([]byte(`{"rows":[{"uuid":"` + deleted.String() + `"},{"uuid":"` + broken.String() + `"}],` +
+				`"rowCount":2,"total":2,"current":1}`))
+		case "/api/quagga/bgp/getNeighbor/" + deleted.String():
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	_, err = client.BgpNeighborList()
+	if !errors.Is(err, ErrOpnsense500) {
+		t.Errorf("Expected the failed get to be returned, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package opnsense
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how often and how fast a request is repeated after a
+// transient failure, i.e. a connection error or ErrOpnsenseUnavailable. This
+// commonly happens for a few seconds after a reconfigure or service restart
+// while the web server comes back up.
+//
+// GET requests are always retried, POST requests only if RetryPost returns
+// true for the endpoint. The zero value does not retry at all.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+	// InitialBackoff is the wait before the second attempt. It doubles for
+	// every further attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter is the fraction, between 0 and 1, of every backoff that is
+	// randomised to spread out retries from concurrent callers.
+	Jitter float64
+	// RetryPost reports whether a POST to api is safe to send again. It
+	// defaults to IdempotentPost.
+	RetryPost func(api string) bool
+}
+
+// DefaultRetryPolicy retries for roughly ten seconds, which covers the
+// restart of the web server on small appliances.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         0.2,
+		RetryPost:      IdempotentPost,
+	}
+}
+
+// WithRetryPolicy makes the client retry transient failures according to
+// policy. The context of a request is honoured while waiting between
+// attempts.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(cfg *clientConfig) error {
+		cfg.retryPolicy = policy
+
+		return nil
+	}
+}
+
+// IdempotentPost reports whether the POST endpoint api can be repeated
+// without changing the outcome. Reads, updates of existing items, explicit
+// toggles and service actions are; adding and deleting items, firmware
+// actions and alias_util changes are not.
+func IdempotentPost(api string) bool {
+	segments := strings.Split(strings.Trim(api, "/"), "/")
+	if len(segments) < 3 {
+		return false
+	}
+
+	action := strings.ToLower(segments[2])
+
+	switch {
+	case strings.HasPrefix(action, "toggle"):
+		// Without the desired state toggling flips the current one.
+		return len(segments) >= 5
+	case strings.HasPrefix(action, "get"),
+		strings.HasPrefix(action, "search"),
+		strings.HasPrefix(action, "set"),
+		strings.HasPrefix(action, "show"):
+		return true
+	}
+
+	switch action {
+	case "reconfigure", "apply", "savepoint", "revert", "cancelrollback",
+		"restart", "start", "stop", "status":
+		return true
+	}
+
+	return false
+}
+
+func (p RetryPolicy) retryable(ctx context.Context, method, api string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if method == http.MethodPost {
+		retryPost := p.RetryPost
+		if retryPost == nil {
+			retryPost = IdempotentPost
+		}
+
+		if !retryPost(api) {
+			return false
+		}
+	}
+
+	// Any other APIError is an answer from OPNsense that will not change by
+	// asking again, errors without a status are connection failures.
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return errors.Is(err, ErrOpnsenseUnavailable)
+	}
+
+	return true
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || backoff < p.MaxBackoff); i++ {
+		backoff *= 2
+	}
+
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		//nolint:gosec // Jitter does not need a secure source of randomness.
+		backoff -= time.Duration(float64(backoff) * p.Jitter * rand.Float64())
+	}
+
+	return backoff
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
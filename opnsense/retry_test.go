@@ -0,0 +1,123 @@
+package opnsense
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFlakyServer(t *testing.T, failures int32) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(http.StatusBadGateway)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"result":"saved","status":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	server, requests := newFlakyServer(t, 2)
+
+	client, err := New(WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	_, err = client.AliasReconfigure()
+	if err != nil {
+		t.Errorf("Expected reconfigure to succeed after retries, got: %v", err)
+	}
+
+	if *requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", *requests)
+	}
+}
+
+func TestRetrySkipsUnsafePost(t *testing.T) {
+	server, requests := newFlakyServer(t, 1)
+
+	client, err := New(WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	_, err = client.AliasAdd(AliasFormat{Name: "test"})
+	if !errors.Is(err, ErrOpnsenseUnavailable) {
+		t.Errorf("Expected unavailable error, got: %v", err)
+	}
+
+	if *requests != 1 {
+		t.Errorf("Expected a single request, got %d", *requests)
+	}
+}
+
+func TestRetryHonoursContext(t *testing.T) {
+	server, _ := newFlakyServer(t, 100)
+
+	client, err := New(WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    100,
+		InitialBackoff: time.Hour,
+	}))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.FirmwareStatusCtx(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline error, got: %v", err)
+	}
+}
+
+func TestIdempotentPost(t *testing.T) {
+	safe := []string{
+		"firewall/alias/reconfigure",
+		"firewall/filter/apply/1234",
+		"firewall/filter/getRule/uuid",
+		"firewall/filter/setRule/uuid",
+		"firewall/filter/toggleRule/uuid/1",
+		"wireguard/service/restart",
+	}
+	unsafe := []string{
+		"firewall/alias/addItem",
+		"firewall/filter/delRule/uuid",
+		"firewall/filter/toggleRule/uuid",
+		"core/firmware/upgrade",
+		"core/firmware/install/os-wireguard",
+		"firewall/alias_util/add/name",
+	}
+
+	for _, api := range safe {
+		if !IdempotentPost(api) {
+			t.Errorf("Expected %s to be idempotent", api)
+		}
+	}
+
+	for _, api := range unsafe {
+		if IdempotentPost(api) {
+			t.Errorf("Expected %s not to be idempotent", api)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package opnsense
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultSearchRowCount is the page size used when SearchOptions does not
+// set one. OPNsense itself defaults to a single page of a few rows.
+const DefaultSearchRowCount = 500
+
+// SearchOptions are the parameters the bootgrid tables of the web UI send to
+// the search actions of the MVC API.
+type SearchOptions struct {
+	// Current is the page to fetch, starting at 1.
+	Current int
+	// RowCount is the number of rows per page, -1 returns every row at once.
+	RowCount int
+	// Sort maps column names to "asc" or "desc".
+	Sort map[string]string
+	// SearchPhrase filters the rows on the searchable columns.
+	SearchPhrase string
+	// Filters are additional parameters some controllers accept, e.g.
+	// category for firewall/filter/searchRule.
+	Filters map[string]interface{}
+}
+
+func (o SearchOptions) body() map[string]interface{} {
+	body := map[string]interface{}{}
+
+	for k, v := range o.Filters {
+		body[k] = v
+	}
+
+	body["current"] = o.Current
+	if o.Current < 1 {
+		body["current"] = 1
+	}
+
+	body["rowCount"] = o.RowCount
+	if o.RowCount == 0 {
+		body["rowCount"] = DefaultSearchRowCount
+	}
+
+	if len(o.Sort) > 0 {
+		body["sort"] = o.Sort
+	}
+
+	body["searchPhrase"] = o.SearchPhrase
+
+	return body
+}
+
+// SearchPage is a single page of rows returned by a search action.
+type SearchPage[T any] struct {
+	Rows []T `json:"rows"`
+	SearchResultPartial
+}
+
+// Search fetches the page of the search action at api described by opts.
+func Search[T any](ctx context.Context, c *Client, api string, opts SearchOptions) (*SearchPage[T], error) {
+	var response SearchPage[T]
+
+	err := c.PostAndMarshalCtx(ctx, api, opts.body(), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SearchAll iterates over every row of the search action at api, starting at
+// the page in opts and fetching further pages as they are needed. Iteration
+// stops after the first error, which is yielded with the zero value of T.
+func SearchAll[T any](ctx context.Context, c *Client, api string, opts SearchOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if opts.Current < 1 {
+			opts.Current = 1
+		}
+
+		if opts.RowCount == 0 {
+			opts.RowCount = DefaultSearchRowCount
+		}
+
+		// Rows on the pages before the first one fetched count towards the
+		// total reported by OPNsense.
+		seen := (opts.Current - 1) * max(opts.RowCount, 0)
+
+		for {
+			page, err := Search[T](ctx, c, api, opts)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, row := range page.Rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			seen += len(page.Rows)
+
+			if opts.RowCount < 0 || len(page.Rows) == 0 || seen >= page.Total {
+				return
+			}
+
+			opts.Current++
+		}
+	}
+}
+
+// collect gathers the rows yielded by seq.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	rows := []T{}
+
+	for row, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
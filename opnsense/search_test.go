@@ -0,0 +1,93 @@
+package opnsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchAllWalksPages(t *testing.T) {
+	const total = 7
+
+	requests := []map[string]interface{}{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, body)
+
+		current := int(body["current"].(float64))
+		rowCount := int(body["rowCount"].(float64))
+
+		rows := []AliasListItem{}
+		for i := (current - 1) * rowCount; i < min(current*rowCount, total); i++ {
+			rows = append(rows, AliasListItem{Name: fmt.Sprintf("alias%d", i)})
+		}
+
+		_ = json.NewEncoder(w).Encode(SearchPage[AliasListItem]{
+			Rows: rows,
+			SearchResultPartial: SearchResultPartial{
+				RowCount: len(rows),
+				Total:    total,
+				Current:  current,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	opts := SearchOptions{
+		RowCount:     3,
+		Sort:         map[string]string{"name": "asc"},
+		SearchPhrase: "alias",
+	}
+
+	names := []string{}
+
+	for alias, err := range client.AliasSearchAll(context.Background(), opts) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		names = append(names, alias.Name)
+	}
+
+	if len(names) != total || names[0] != "alias0" || names[total-1] != "alias6" {
+		t.Errorf("Expected all %d aliases, got %v", total, names)
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("Expected 3 pages to be fetched, got %d", len(requests))
+	}
+
+	last := requests[2]
+	if last["current"] != 3.0 || last["searchPhrase"] != "alias" || last["sort"].(map[string]interface{})["name"] != "asc" {
+		t.Errorf("Unexpected request body: %v", last)
+	}
+
+	list, err := client.AliasGetList()
+	if err != nil || len(list.Rows) != total || list.Total != total {
+		t.Errorf("Expected AliasGetList to return every alias, got %v, %v", list, err)
+	}
+
+	if requests[3]["rowCount"] != float64(DefaultSearchRowCount) {
+		t.Errorf("Expected default row count, got %v", requests[3])
+	}
+
+	// Stopping early does not fetch further pages.
+	for range client.AliasSearchAll(context.Background(), opts) {
+		break
+	}
+
+	if len(requests) != 5 {
+		t.Errorf("Expected a single page to be fetched, got %d requests", len(requests)-4)
+	}
+}
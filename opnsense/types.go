@@ -3,9 +3,13 @@ package opnsense
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 const (
@@ -14,6 +18,7 @@ const (
 	StatusDone    = "done"
 	StatusRunning = "running"
 	StatusOK      = "ok"
+	StatusFailed  = "failed"
 )
 
 var (
@@ -22,10 +27,24 @@ var (
 	ErrOpnsenseDone                              = errors.New("did not finish")
 	ErrOpnsenseStatusNotOk                       = errors.New("status did not return ok")
 	ErrOpnsenseEmptyListNotFound                 = errors.New("found empty array, most likely 404")
+	ErrOpnsenseNotFound                          = errors.New("not found")
 	ErrOpnsense500                               = errors.New("internal server error")
 	ErrOpnsense401                               = errors.New("authentication failed")
+	ErrOpnsense403                               = errors.New("permission denied")
+	ErrOpnsensePluginMissing                     = errors.New("endpoint not found, most likely the plugin is not installed")
+	ErrOpnsenseUnavailable                       = errors.New("service temporarily unavailable")
+	ErrOpnsenseUnexpectedStatus                  = errors.New("unexpected status code")
+	ErrOpnsenseFilterRolledBack                  = errors.New("firewall changes were rolled back")
+	ErrOpnsenseFilterRollbackPending             = errors.New("firewall changes will be rolled back automatically")
 	ErrOpnsenseBoolUnmarshal                     = errors.New("failed to unmarshal OPNsense bool")
 	ErrOpnsenseBoolMarshal                       = errors.New("failed to marshal OPNsense bool")
+	ErrOpnsenseMissingUUID                       = errors.New("item has no UUID")
+	ErrOpnsenseDuplicateUUID                     = errors.New("UUID is listed more than once")
+	ErrOpnsenseInvalidScope                      = errors.New("scope selects every rule")
+	ErrOpnsenseOutOfScope                        = errors.New("rule is outside of the scope")
+	ErrOpnsenseDuplicateRule                     = errors.New("rule description is not unique")
+	ErrOpnsenseUnknownInterface                  = errors.New("interface does not exist")
+	ErrOpnsenseInvalidOption                     = errors.New("option is invalid")
 	ErrOpnsenseInvalidPort                       = errors.New("port is invalid")
 	ErrOpnsenseInvalidPortRange                  = errors.New("port range is invalid")
 	ErrOpnsenseInvalidPortRangeToSmallerThanFrom = errors.New("port range is invalid, to smaller than from")
@@ -168,6 +187,8 @@ func (s *Selected) UnmarshalJSON(b []byte) error {
 		} else {
 			s.Selected = 0
 		}
+
+		return nil
 	}
 
 	s.Value = temp.Value
@@ -281,63 +302,279 @@ func portFromString(portStr string) (Port, error) {
 	return port, nil
 }
 
+// PortRange is the port field of a rule. It is one of:
+//
+//   - any port, the zero value, stored by OPNsense as an empty string,
+//   - a single port, where only From is set, e.g. 443,
+//   - a range of ports from From to To, e.g. 8000-8080,
+//   - a port alias or well-known service name in Name, e.g. WebPorts or https.
+//
+// It is written back to OPNsense in the form it was read in, so 20-20 stays
+// a range while 20 stays a single port.
 type PortRange struct {
 	From Port
 	To   Port
+	Name string
+}
+
+// NewPort returns a PortRange matching the single port p.
+func NewPort(p Port) *PortRange {
+	return &PortRange{From: p}
+}
+
+// NewPortRange returns a PortRange matching the ports from from to to.
+func NewPortRange(from, to Port) *PortRange {
+	return &PortRange{From: from, To: to}
+}
+
+// NewNamedPort returns a PortRange matching the port alias or well-known
+// service called name.
+func NewNamedPort(name string) *PortRange {
+	return &PortRange{Name: name}
+}
+
+// IsAny reports whether pr matches every port.
+func (pr *PortRange) IsAny() bool {
+	return pr == nil || *pr == PortRange{}
+}
+
+// String returns pr the way OPNsense stores it.
+func (pr *PortRange) String() string {
+	switch {
+	case pr.IsAny():
+		return ""
+	case pr.Name != "":
+		return pr.Name
+	case pr.To == 0:
+		return strconv.Itoa(int(pr.From))
+	}
+
+	return strconv.Itoa(int(pr.From)) + "-" + strconv.Itoa(int(pr.To))
 }
 
 func (pr *PortRange) UnmarshalJSON(b []byte) error {
 	var txt string
 
-	portRange := PortRange{}
-
 	err := json.Unmarshal(b, &txt)
 	if err != nil {
 		return err
 	}
 
+	portRange, err := parsePortRange(strings.TrimSpace(txt))
+	if err != nil {
+		return err
+	}
+
+	*pr = portRange
+
+	return nil
+}
+
+func parsePortRange(txt string) (PortRange, error) {
+	switch {
+	case txt == "" || strings.EqualFold(txt, "any"):
+		return PortRange{}, nil
+	case isPortName(txt):
+		return PortRange{Name: txt}, nil
+	case !strings.Contains(txt, "-"):
+		port, err := portFromString(txt)
+		if err != nil {
+			return PortRange{}, err
+		}
+
+		return PortRange{From: port}, nil
+	}
+
 	ports := strings.Split(txt, "-")
 
 	if len(ports) != 2 {
-		return ErrOpnsenseInvalidPortRange
+		return PortRange{}, ErrOpnsenseInvalidPortRange
 	}
 
-	fromStr := ports[0]
-	toStr := ports[1]
+	from, err := portFromString(ports[0])
+	if err != nil {
+		return PortRange{}, err
+	}
 
-	from, err := portFromString(fromStr)
+	to, err := portFromString(ports[1])
+	if err != nil {
+		return PortRange{}, err
+	}
+
+	if to < from {
+		return PortRange{}, ErrOpnsenseInvalidPortRangeToSmallerThanFrom
+	}
+
+	return PortRange{From: from, To: to}, nil
+}
+
+// isPortName reports whether txt is the name of a port alias or service
+// rather than a number. Names start with a letter or underscore and consist
+// of letters, digits, underscores, dots and dashes.
+func isPortName(txt string) bool {
+	for i, r := range txt {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case i > 0 && (r >= '0' && r <= '9' || r == '.' || r == '-'):
+		default:
+			return false
+		}
+	}
+
+	return txt != ""
+}
+
+func (pr *PortRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pr.String())
+}
+
+type NetworkOrAlias string
+
+// Protocol is the protocol of a rule, e.g. TCP or any.
+type Protocol string
+
+func (p *Protocol) UnmarshalJSON(b []byte) error {
+	option, err := optionFromJSON(b, nil)
 	if err != nil {
 		return err
 	}
 
-	to, err := portFromString(toStr)
+	*p = Protocol(option)
+
+	return nil
+}
+
+// Interface is the name of one or more interfaces, e.g. lan or lan,opt1 for
+// rules matching several.
+type Interface string
+
+func (i *Interface) UnmarshalJSON(b []byte) error {
+	var txt string
+
+	err := json.Unmarshal(b, &txt)
+	if err == nil {
+		*i = Interface(txt)
+
+		return nil
+	}
+
+	var choices SelectedMap
+
+	err = json.Unmarshal(b, &choices)
 	if err != nil {
 		return err
 	}
 
-	if to < from {
-		return ErrOpnsenseInvalidPortRangeToSmallerThanFrom
+	selected := ListSelectedKeys(choices)
+	sort.Strings(selected)
+
+	*i = Interface(strings.Join(selected, ","))
+
+	return nil
+}
+
+// Categories are the UUIDs of the categories an item is in.
+type Categories []uuid.UUID
+
+// UnmarshalJSON reads the categories from get, where they are a map of every
+// category with the selected ones marked, or from a comma separated list of
+// UUIDs. Search returns names instead, FirewallFilterRuleSearchAll resolves
+// those.
+func (c *Categories) UnmarshalJSON(b []byte) error {
+	var keys []string
+
+	var txt string
+
+	err := json.Unmarshal(b, &txt)
+	if err == nil {
+		keys = strings.Split(txt, ",")
+	} else {
+		var choices SelectedMap
+
+		err = json.Unmarshal(b, &choices)
+		if err != nil {
+			return err
+		}
+
+		keys = ListSelectedKeys(choices)
+		sort.Strings(keys)
 	}
 
-	portRange.From = from
-	portRange.To = to
+	categories := Categories{}
 
-	*pr = portRange
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+
+		id, err := uuid.FromString(key)
+		if err != nil {
+			return fmt.Errorf("%w: category %q is not a UUID", ErrOpnsenseInvalidOption, key)
+		}
+
+		categories = append(categories, id)
+	}
+
+	*c = categories
 
 	return nil
 }
 
-func (pr *PortRange) MarshalJSON() ([]byte, error) {
-	fromStr := strconv.Itoa(int(pr.From))
-	toStr := strconv.Itoa(int(pr.To))
+func (c Categories) MarshalJSON() ([]byte, error) {
+	ids := make([]string, len(c))
+	for i, id := range c {
+		ids[i] = id.String()
+	}
+
+	return json.Marshal(strings.Join(ids, ","))
+}
 
-	r := fromStr + "-" + toStr
+// Contains reports whether id is one of the categories.
+func (c Categories) Contains(id uuid.UUID) bool {
+	for _, category := range c {
+		if uuid.Equal(category, id) {
+			return true
+		}
+	}
 
-	return json.Marshal(r)
+	return false
 }
 
-type NetworkOrAlias string
+// optionFromJSON reads the selected key of an option field. get returns the
+// field as a map of every choice, search as the display value of the
+// selected one, which is mapped back to its key with display.
+func optionFromJSON(b []byte, display map[string]string) (string, error) {
+	var txt string
 
-type Protocol string
+	err := json.Unmarshal(b, &txt)
+	if err == nil {
+		if key, ok := display[strings.ToLower(txt)]; ok {
+			return key, nil
+		}
 
-type Interface string
+		return txt, nil
+	}
+
+	var choices SelectedMap
+
+	err = json.Unmarshal(b, &choices)
+	if err != nil {
+		return "", err
+	}
+
+	selected := ListSelectedKeys(choices)
+	if len(selected) == 0 {
+		return "", nil
+	}
+
+	return selected[0], nil
+}
+
+func optionToJSON(field, value string, valid bool) ([]byte, error) {
+	if value != "" && !valid {
+		return nil, fmt.Errorf("%w: %s %q", ErrOpnsenseInvalidOption, field, value)
+	}
+
+	return json.Marshal(value)
+}
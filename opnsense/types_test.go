@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -233,6 +234,18 @@ func TestSelected_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+// TestSelected_UnmarshalJSONBool checks that a bool selected is kept instead
+// of being overwritten by the failed int decode.
+func TestSelected_UnmarshalJSONBool(t *testing.T) {
+	var s Selected
+
+	require.NoError(t, json.Unmarshal([]byte(`{"value": "LAN", "selected": true}`), &s))
+	require.Equal(t, Selected{Value: "LAN", Selected: 1}, s)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"value": "WAN", "selected": false}`), &s))
+	require.Equal(t, Selected{Value: "WAN", Selected: 0}, s)
+}
+
 func TestBool_MarshalJSON(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -450,3 +463,129 @@ func TestPortRangeMarshal(t *testing.T) {
 		t.Errorf("Actual is not the same as expected, %s != %s", actual, expected)
 	}
 }
+
+func TestInterfaceAndProtocolUnmarshal(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		iface    Interface
+		protocol Protocol
+	}{
+		"search": {
+			input:    `{"interface": "lan,opt1", "protocol": "TCP"}`,
+			iface:    "lan,opt1",
+			protocol: "TCP",
+		},
+		"get": {
+			input: `{
+				"interface": {
+					"wan": {"value": "WAN", "selected": 1},
+					"lan": {"value": "LAN", "selected": 1},
+					"opt1": {"value": "DMZ", "selected": 0}
+				},
+				"protocol": {"any": {"value": "any", "selected": 0}, "TCP/UDP": {"value": "TCP/UDP", "selected": 1}}
+			}`,
+			iface:    "lan,wan",
+			protocol: "TCP/UDP",
+		},
+		"empty": {
+			input: `{"interface": [], "protocol": []}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rule struct {
+				Interface Interface `json:"interface"`
+				Protocol  Protocol  `json:"protocol"`
+			}
+
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &rule))
+			require.Equal(t, tt.iface, rule.Interface)
+			require.Equal(t, tt.protocol, rule.Protocol)
+		})
+	}
+}
+
+func TestCategories(t *testing.T) {
+	a := uuid.FromStringOrNil("0b3f0a34-34a4-4e32-9bd6-8d6b4d1e0a01")
+	b := uuid.FromStringOrNil("7d1c2e58-6e0f-4b33-a9a6-2b6c0f6a8b02")
+
+	tests := map[string]struct {
+		input    string
+		expected Categories
+	}{
+		"get": {
+			input: `{
+				"` + b.String() + `": {"value": "b", "selected": 1},
+				"` + a.String() + `": {"value": "a", "selected": 1},
+				"3e0e9d8c-0f5a-4b2a-8f3c-5c9d1b7e6a03": {"value": "c", "selected": 0}
+			}`,
+			expected: Categories{a, b},
+		},
+		"uuids": {
+			input:    `"` + a.String() + `,` + b.String() + `"`,
+			expected: Categories{a, b},
+		},
+		"empty": {
+			input:    `[]`,
+			expected: Categories{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var categories Categories
+
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &categories))
+			require.Equal(t, tt.expected, categories)
+		})
+	}
+
+	var categories Categories
+
+	require.ErrorIs(t, json.Unmarshal([]byte(`"managed,other"`), &categories), ErrOpnsenseInvalidOption)
+
+	data, err := json.Marshal(Categories{a, b})
+	require.NoError(t, err)
+	require.JSONEq(t, `"`+a.String()+`,`+b.String()+`"`, string(data))
+	require.True(t, Categories{a}.Contains(a))
+	require.False(t, Categories{a}.Contains(b))
+}
+
+func TestPortRangeRoundTrip(t *testing.T) {
+	tests := map[string]PortRange{
+		`""`:          {},
+		`"443"`:       {From: 443},
+		`"20-20"`:     {From: 20, To: 20},
+		`"8000-8080"`: {From: 8000, To: 8080},
+		`"WebPorts"`:  {Name: "WebPorts"},
+		`"ms-sql-s"`:  {Name: "ms-sql-s"},
+		`"_ports_v2"`: {Name: "_ports_v2"},
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			var pr PortRange
+
+			require.NoError(t, json.Unmarshal([]byte(input), &pr))
+			require.Equal(t, expected, pr)
+
+			data, err := json.Marshal(&pr)
+			require.NoError(t, err)
+			require.Equal(t, input, string(data))
+		})
+	}
+
+	var pr PortRange
+
+	require.NoError(t, json.Unmarshal([]byte(`"any"`), &pr))
+	require.True(t, pr.IsAny())
+
+	for _, invalid := range []string{`"0"`, `"65536"`, `"1-2-3"`, `"80-"`, `"4four"`, `"web ports"`} {
+		require.Error(t, json.Unmarshal([]byte(invalid), &pr), invalid)
+	}
+
+	require.Equal(t, "443", NewPort(443).String())
+	require.Equal(t, "1-1024", NewPortRange(1, 1024).String())
+	require.Equal(t, "https", NewNamedPort("https").String())
+}
@@ -1,9 +1,8 @@
 package opnsense
 
 import (
+	"context"
 	"fmt"
-	"log"
-	"path"
 
 	uuid "github.com/satori/go.uuid"
 )
@@ -11,11 +10,15 @@ import (
 // Requires: os-wireguard-devel
 
 func (c *Client) WireGuardRestart() (*GenericResponse, error) {
+	return c.WireGuardRestartCtx(context.Background())
+}
+
+func (c *Client) WireGuardRestartCtx(ctx context.Context) (*GenericResponse, error) {
 	api := "wireguard/service/restart"
 
 	var response GenericResponse
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -24,11 +27,15 @@ func (c *Client) WireGuardRestart() (*GenericResponse, error) {
 }
 
 func (c *Client) WireGuardStart() (*GenericResponse, error) {
+	return c.WireGuardStartCtx(context.Background())
+}
+
+func (c *Client) WireGuardStartCtx(ctx context.Context) (*GenericResponse, error) {
 	api := "wireguard/service/start"
 
 	var response GenericResponse
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -37,11 +44,15 @@ func (c *Client) WireGuardStart() (*GenericResponse, error) {
 }
 
 func (c *Client) WireGuardStop() (*GenericResponse, error) {
+	return c.WireGuardStopCtx(context.Background())
+}
+
+func (c *Client) WireGuardStopCtx(ctx context.Context) (*GenericResponse, error) {
 	api := "wireguard/service/stop"
 
 	var response GenericResponse
 
-	err := c.PostAndMarshal(api, nil, &response)
+	err := c.PostAndMarshalCtx(ctx, api, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -50,19 +61,27 @@ func (c *Client) WireGuardStop() (*GenericResponse, error) {
 }
 
 func (c *Client) WireGuardShowConfig() (*GenericResponse, error) {
+	return c.WireGuardShowConfigCtx(context.Background())
+}
+
+func (c *Client) WireGuardShowConfigCtx(ctx context.Context) (*GenericResponse, error) {
 	api := "wireguard/service/showconf"
 
 	var response GenericResponse
-	err := c.GetAndUnmarshal(api, &response)
+	err := c.GetAndUnmarshalCtx(ctx, api, &response)
 
 	return &response, err
 }
 
 func (c *Client) WireGuardShowHandshake() (*GenericResponse, error) {
+	return c.WireGuardShowHandshakeCtx(context.Background())
+}
+
+func (c *Client) WireGuardShowHandshakeCtx(ctx context.Context) (*GenericResponse, error) {
 	api := "wireguard/service/showhandshake"
 
 	var response GenericResponse
-	err := c.GetAndUnmarshal(api, &response)
+	err := c.GetAndUnmarshalCtx(ctx, api, &response)
 
 	return &response, err
 }
@@ -76,41 +95,53 @@ type WireGuardSettingsGeneral struct {
 }
 
 func (c *Client) WireGuardSettingsGet() (*WireGuardSettings, error) {
+	return c.WireGuardSettingsGetCtx(context.Background())
+}
+
+func (c *Client) WireGuardSettingsGetCtx(ctx context.Context) (*WireGuardSettings, error) {
 	api := "wireguard/general/get"
 
 	var response WireGuardSettings
-	err := c.GetAndUnmarshal(api, &response)
+	err := c.GetAndUnmarshalCtx(ctx, api, &response)
 
 	return &response, err
 }
 
 func (c *Client) WireGuardSettingsSet(settings WireGuardSettings) (*GenericResponse, error) {
+	return c.WireGuardSettingsSetCtx(context.Background(), settings)
+}
+
+func (c *Client) WireGuardSettingsSetCtx(ctx context.Context, settings WireGuardSettings) (*GenericResponse, error) {
 	api := "wireguard/general/set"
 
 	var response GenericResponse
 
-	err := c.PostAndMarshal(api, settings, &response)
+	err := c.PostAndMarshalCtx(ctx, api, settings, &response)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.Result != StatusSaved {
-		log.Printf("[TRACE] WireGuardSettingsSet response: %#v", response)
+		c.logger.DebugContext(ctx, "WireGuardSettingsSet response", "response", response)
 
-		return nil, fmt.Errorf("WireGuardSettingsSet failed: %w", ErrOpnsenseSave)
+		return nil, fmt.Errorf("WireGuardSettingsSet failed: %w", newResultError(api, &response, ErrOpnsenseSave))
 	}
 
 	return &response, nil
 }
 
 func (c *Client) WireGuardEnableService() error {
+	return c.WireGuardEnableServiceCtx(context.Background())
+}
+
+func (c *Client) WireGuardEnableServiceCtx(ctx context.Context) error {
 	ws := WireGuardSettings{
 		WireGuardSettingsGeneral{
 			Enabled: "1",
 		},
 	}
 
-	_, err := c.WireGuardSettingsSet(ws)
+	_, err := c.WireGuardSettingsSetCtx(ctx, ws)
 	if err != nil {
 		return err
 	}
@@ -119,13 +150,17 @@ func (c *Client) WireGuardEnableService() error {
 }
 
 func (c *Client) WireGuardDisableService() error {
+	return c.WireGuardDisableServiceCtx(context.Background())
+}
+
+func (c *Client) WireGuardDisableServiceCtx(ctx context.Context) error {
 	ws := WireGuardSettings{
 		WireGuardSettingsGeneral{
 			Enabled: "0",
 		},
 	}
 
-	_, err := c.WireGuardSettingsSet(ws)
+	_, err := c.WireGuardSettingsSetCtx(ctx, ws)
 	if err != nil {
 		return err
 	}
@@ -154,136 +189,80 @@ type WireGuardClientGet struct {
 	TunnelAddress SelectedMap `json:"tunneladdress"`
 }
 
-func (c *Client) WireGuardClientGet(uuid uuid.UUID) (*WireGuardClientGet, error) {
-	api := path.Join("wireguard/client/getclient", uuid.String())
-
-	type Response struct {
-		Client WireGuardClientGet `json:"client"`
-	}
-
-	var response Response
+func (b *WireGuardClientBase) setUUID(id uuid.UUID) {
+	b.UUID = &id
+}
 
-	err := c.GetAndUnmarshal(api, &response)
+func (c *Client) wireGuardClientResource() *Resource[WireGuardClientGet, WireGuardClientSet] {
+	return NewResource[WireGuardClientGet, WireGuardClientSet](c, ResourceConfig{
+		Module:      "wireguard/client",
+		Key:         "client",
+		Suffix:      "client",
+		Reconfigure: "wireguard/service/reconfigure",
+	})
+}
 
-	// UUID does not exist in the JSON, so we add it since we know it.
-	response.Client.UUID = &uuid
+func (c *Client) WireGuardClientGet(uuid uuid.UUID) (*WireGuardClientGet, error) {
+	return c.WireGuardClientGetCtx(context.Background(), uuid)
+}
 
-	return &response.Client, err
+func (c *Client) WireGuardClientGetCtx(ctx context.Context, uuid uuid.UUID) (*WireGuardClientGet, error) {
+	return c.wireGuardClientResource().Get(ctx, uuid)
 }
 
 func (c *Client) WireGuardClientGetUUIDs() ([]*uuid.UUID, error) {
-	api := "wireguard/client/searchclient"
-
-	var response SearchResult
-
-	err := c.GetAndUnmarshal(api, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	uuids := []*uuid.UUID{}
-
-	for _, row := range response.Rows {
-		m, ok := row.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		id, ok := m["uuid"].(string)
-		if !ok {
-			continue
-		}
-
-		uuid, err := uuid.FromString(id)
-		if err == nil {
-			uuids = append(uuids, &uuid)
-		}
-	}
+	return c.WireGuardClientGetUUIDsCtx(context.Background())
+}
 
-	return uuids, err
+func (c *Client) WireGuardClientGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	return c.wireGuardClientResource().UUIDs(ctx)
 }
 
 func (c *Client) WireGuardClientList() ([]*WireGuardClientGet, error) {
-	uuids, err := c.WireGuardClientGetUUIDs()
-	if err != nil {
-		return nil, err
-	}
-
-	clients := []*WireGuardClientGet{}
-
-	for _, uuid := range uuids {
-		client, err := c.WireGuardClientGet(*uuid)
-		if err == nil {
-			clients = append(clients, client)
-		}
-	}
+	return c.WireGuardClientListCtx(context.Background())
+}
 
-	return clients, nil
+func (c *Client) WireGuardClientListCtx(ctx context.Context) ([]*WireGuardClientGet, error) {
+	return c.wireGuardClientResource().List(ctx)
 }
 
 func (c *Client) WireGuardClientSet(uuid uuid.UUID, clientConf WireGuardClientSet) (*GenericResponse, error) {
-	api := path.Join("wireguard/client/setclient", uuid.String())
-
-	request := map[string]interface{}{
-		"client": clientConf,
-	}
-
-	var response GenericResponse
+	return c.WireGuardClientSetCtx(context.Background(), uuid, clientConf)
+}
 
-	err := c.PostAndMarshal(api, request, &response)
+func (c *Client) WireGuardClientSetCtx(ctx context.Context, uuid uuid.UUID, clientConf WireGuardClientSet) (*GenericResponse, error) {
+	response, err := c.wireGuardClientResource().Set(ctx, uuid, clientConf)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("WireGuardClientSet failed: %w", err)
 	}
 
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] WireGuardClientSet response: %#v", response)
-
-		return nil, fmt.Errorf("WireGuardClientSet failed: %w", ErrOpnsenseSave)
-	}
-
-	return &response, nil
+	return response, nil
 }
 
 func (c *Client) WireGuardClientAdd(clientConf WireGuardClientSet) (*uuid.UUID, error) {
-	api := "wireguard/client/addclient"
-
-	request := map[string]interface{}{
-		"client": clientConf,
-	}
-
-	var response GenericResponse
+	return c.WireGuardClientAddCtx(context.Background(), clientConf)
+}
 
-	err := c.PostAndMarshal(api, request, &response)
+func (c *Client) WireGuardClientAddCtx(ctx context.Context, clientConf WireGuardClientSet) (*uuid.UUID, error) {
+	response, err := c.wireGuardClientResource().Add(ctx, clientConf)
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] WireGuardClientAdd response: %#v", response)
-
-		return nil, fmt.Errorf("WireGuardClientAdd failed: %w", ErrOpnsenseSave)
+		return nil, fmt.Errorf("WireGuardClientAdd failed: %w", err)
 	}
 
 	return response.UUID, nil
 }
 
 func (c *Client) WireGuardClientDelete(uuid uuid.UUID) (*GenericResponse, error) {
-	api := path.Join("wireguard/client/delclient", uuid.String())
-
-	var response GenericResponse
+	return c.WireGuardClientDeleteCtx(context.Background(), uuid)
+}
 
-	err := c.PostAndMarshal(api, nil, &response)
+func (c *Client) WireGuardClientDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error) {
+	response, err := c.wireGuardClientResource().Delete(ctx, uuid)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("WireGuardClientDelete failed: %w", err)
 	}
 
-	if response.Result != StatusDeleted {
-		log.Printf("[TRACE] WireGuardClientDelete response: %#v", response)
-
-		return nil, fmt.Errorf("WireGuardClientDelete failed: %w", ErrOpnsenseDelete)
-	}
-
-	return &response, nil
+	return response, nil
 }
 
 type WireGuardServerBase struct {
@@ -313,170 +292,111 @@ type WireGuardServerGet struct {
 	Peers         SelectedMap `json:"peers"`
 }
 
-func (c *Client) WireGuardServerGet(uuid uuid.UUID) (*WireGuardServerGet, error) {
-	api := path.Join("wireguard/server/getserver", uuid.String())
-
-	type Response struct {
-		Server WireGuardServerGet `json:"server"`
-	}
-
-	var response Response
+func (b *WireGuardServerBase) setUUID(id uuid.UUID) {
+	b.UUID = &id
+}
 
-	err := c.GetAndUnmarshal(api, &response)
+func (c *Client) wireGuardServerResource() *Resource[WireGuardServerGet, WireGuardServerSet] {
+	return NewResource[WireGuardServerGet, WireGuardServerSet](c, ResourceConfig{
+		Module:      "wireguard/server",
+		Key:         "server",
+		Suffix:      "server",
+		Reconfigure: "wireguard/service/reconfigure",
+	})
+}
 
-	// UUID does not exist in the JSON, so we add it since we know it.
-	response.Server.UUID = &uuid
+func (c *Client) WireGuardServerGet(uuid uuid.UUID) (*WireGuardServerGet, error) {
+	return c.WireGuardServerGetCtx(context.Background(), uuid)
+}
 
-	return &response.Server, err
+func (c *Client) WireGuardServerGetCtx(ctx context.Context, uuid uuid.UUID) (*WireGuardServerGet, error) {
+	return c.wireGuardServerResource().Get(ctx, uuid)
 }
 
 func (c *Client) WireGuardServerGetUUIDs() ([]*uuid.UUID, error) {
-	api := "wireguard/server/searchserver"
-
-	var response SearchResult
-
-	err := c.GetAndUnmarshal(api, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	uuids := []*uuid.UUID{}
-
-	for _, row := range response.Rows {
-		m, ok := row.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		id, ok := m["uuid"].(string)
-		if !ok {
-			continue
-		}
-
-		uuid, err := uuid.FromString(id)
-		if err == nil {
-			uuids = append(uuids, &uuid)
-		}
-	}
+	return c.WireGuardServerGetUUIDsCtx(context.Background())
+}
 
-	return uuids, err
+func (c *Client) WireGuardServerGetUUIDsCtx(ctx context.Context) ([]*uuid.UUID, error) {
+	return c.wireGuardServerResource().UUIDs(ctx)
 }
 
 func (c *Client) WireGuardServerFindUUIDByName(name string) ([]*uuid.UUID, error) {
-	api := "wireguard/server/searchserver"
+	return c.WireGuardServerFindUUIDByNameCtx(context.Background(), name)
+}
+
+func (c *Client) WireGuardServerFindUUIDByNameCtx(ctx context.Context, name string) ([]*uuid.UUID, error) {
+	type Row struct {
+		UUID string `json:"uuid"`
+		Name string `json:"name"`
+	}
 
-	var response SearchResult
+	resource := c.wireGuardServerResource()
 
-	err := c.GetAndUnmarshal(api, &response)
+	rows, err := collect(SearchAll[Row](ctx, c, resource.searchEndpoint(), SearchOptions{SearchPhrase: name}))
 	if err != nil {
 		return nil, err
 	}
 
 	uuids := []*uuid.UUID{}
 
-	for _, row := range response.Rows {
-		m, ok := row.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		if rowName, ok := m["name"].(string); !ok || rowName != name {
+	for _, row := range rows {
+		if row.Name != name {
 			continue
 		}
 
-		id, ok := m["uuid"].(string)
-		if !ok {
-			continue
-		}
-
-		uuid, err := uuid.FromString(id)
+		uuid, err := uuid.FromString(row.UUID)
 		if err == nil {
 			uuids = append(uuids, &uuid)
 		}
 	}
 
-	return uuids, err
+	return uuids, nil
 }
 
 func (c *Client) WireGuardServerList() ([]*WireGuardServerGet, error) {
-	uuids, err := c.WireGuardServerGetUUIDs()
-	if err != nil {
-		return nil, err
-	}
-
-	servers := []*WireGuardServerGet{}
-
-	for _, uuid := range uuids {
-		server, err := c.WireGuardServerGet(*uuid)
-		if err == nil {
-			servers = append(servers, server)
-		}
-	}
+	return c.WireGuardServerListCtx(context.Background())
+}
 
-	return servers, nil
+func (c *Client) WireGuardServerListCtx(ctx context.Context) ([]*WireGuardServerGet, error) {
+	return c.wireGuardServerResource().List(ctx)
 }
 
 func (c *Client) WireGuardServerSet(uuid uuid.UUID, serverConf WireGuardServerSet) (*GenericResponse, error) {
-	api := path.Join("wireguard/server/setserver", uuid.String())
-
-	request := map[string]interface{}{
-		"server": serverConf,
-	}
-
-	var response GenericResponse
+	return c.WireGuardServerSetCtx(context.Background(), uuid, serverConf)
+}
 
-	err := c.PostAndMarshal(api, request, &response)
+func (c *Client) WireGuardServerSetCtx(ctx context.Context, uuid uuid.UUID, serverConf WireGuardServerSet) (*GenericResponse, error) {
+	response, err := c.wireGuardServerResource().Set(ctx, uuid, serverConf)
 	if err != nil {
-		return nil, err
-	}
-
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] WireGuardServerSet response: %#v", response)
-
-		return nil, fmt.Errorf("WireGuardServerSet failed: %w", ErrOpnsenseSave)
+		return nil, fmt.Errorf("WireGuardServerSet failed: %w", err)
 	}
 
-	return &response, nil
+	return response, nil
 }
 
-func (c *Client) WireGuardServerAdd(serverConf WireGuardServerSet) error {
-	api := "wireguard/server/addserver"
-
-	request := map[string]interface{}{
-		"server": serverConf,
-	}
-
-	var response GenericResponse
+func (c *Client) WireGuardServerAdd(serverConf WireGuardServerSet) (*uuid.UUID, error) {
+	return c.WireGuardServerAddCtx(context.Background(), serverConf)
+}
 
-	err := c.PostAndMarshal(api, request, &response)
+func (c *Client) WireGuardServerAddCtx(ctx context.Context, serverConf WireGuardServerSet) (*uuid.UUID, error) {
+	response, err := c.wireGuardServerResource().Add(ctx, serverConf)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("WireGuardServerAdd failed: %w", err)
 	}
 
-	if response.Result != StatusSaved {
-		log.Printf("[TRACE] WireGuardServerAdd response: %#v", response)
-
-		return fmt.Errorf("WireGuardServerAdd failed: %w", ErrOpnsenseSave)
-	}
-
-	return nil
+	return response.UUID, nil
 }
 
 func (c *Client) WireGuardServerDelete(uuid uuid.UUID) (*GenericResponse, error) {
-	api := path.Join("wireguard/server/delserver", uuid.String())
-
-	var response GenericResponse
+	return c.WireGuardServerDeleteCtx(context.Background(), uuid)
+}
 
-	err := c.PostAndMarshal(api, nil, &response)
+func (c *Client) WireGuardServerDeleteCtx(ctx context.Context, uuid uuid.UUID) (*GenericResponse, error) {
+	response, err := c.wireGuardServerResource().Delete(ctx, uuid)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("WireGuardServerDelete failed: %w", err)
 	}
 
-	if response.Result != StatusDeleted {
-		log.Printf("[TRACE] WireGuardServerDelete response: %#v", response)
-
-		return nil, fmt.Errorf("WireGuardServerDelete failed: %w", ErrOpnsenseDelete)
-	}
-
-	return &response, nil
+	return response, nil
 }